@@ -0,0 +1,270 @@
+package huh
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Answers is a source of answers used to complete a form without prompting.
+//
+// Answers are looked up by each field's key.
+type Answers interface {
+	Lookup(key string) (any, bool)
+}
+
+// AnswersMap is an [Answers] source backed by a map.
+type AnswersMap map[string]any
+
+// Lookup returns the answer for the given key.
+func (m AnswersMap) Lookup(key string) (any, bool) {
+	v, ok := m[key]
+	return v, ok
+}
+
+// AnswersFromMap returns an answers source backed by the given map.
+func AnswersFromMap(m map[string]any) Answers {
+	return AnswersMap(m)
+}
+
+// AnswersFromFile returns an answers source backed by a JSON or YAML file.
+//
+// Files with a .json extension are decoded as JSON, everything else is
+// decoded as YAML. The file must contain a single object keyed by field keys.
+func AnswersFromFile(path string) (Answers, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("huh: %w", err)
+	}
+
+	m := make(map[string]any)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &m)
+	} else {
+		err = yaml.Unmarshal(data, &m)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("huh: could not decode answers from %s: %w", path, err)
+	}
+	return AnswersMap(m), nil
+}
+
+// answersEnv is an [Answers] source backed by environment variables.
+type answersEnv struct {
+	prefix string
+}
+
+// AnswersFromEnv returns an answers source backed by environment variables.
+//
+// A field's answer is read from the variable named by [EnvName], so with the
+// prefix "APP" the key "first-name" is read from APP_FIRST_NAME. Lists are
+// read as comma separated values.
+func AnswersFromEnv(prefix string) Answers {
+	return answersEnv{prefix: prefix}
+}
+
+// Lookup returns the answer for the given key.
+func (e answersEnv) Lookup(key string) (any, bool) {
+	return os.LookupEnv(EnvName(e.prefix, key))
+}
+
+// EnvName returns the environment variable name of a key, as read by
+// [AnswersFromEnv] and written by [EncodeDotenv].
+//
+// The key is upper cased and any character that isn't an ASCII letter or
+// digit is replaced by an underscore. It is then joined to the prefix, if any,
+// treated the same way, with an underscore. A name starting with a digit is
+// prefixed with an underscore.
+func EnvName(prefix, key string) string {
+	name := envSafe(key)
+	if prefix != "" {
+		name = strings.TrimSuffix(envSafe(prefix), "_") + "_" + name
+	}
+	if name == "" || ('0' <= name[0] && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// envSafe upper cases a string, replacing anything that isn't an ASCII letter
+// or digit with an underscore.
+func envSafe(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, s)
+}
+
+// InvalidAnswer is an answer that failed its field's validation.
+type InvalidAnswer struct {
+	Key string
+	Err error
+}
+
+// AnswersError is the error returned when a form can't be completed from its
+// answers source.
+//
+// Missing lists the keys that had no answer. Invalid lists the answers that
+// failed validation.
+type AnswersError struct {
+	Missing []string
+	Invalid []InvalidAnswer
+}
+
+// Error implements error.
+func (e *AnswersError) Error() string {
+	var parts []string
+	if len(e.Missing) > 0 {
+		parts = append(parts, "missing answers for "+strings.Join(e.Missing, ", "))
+	}
+	for _, invalid := range e.Invalid {
+		parts = append(parts, fmt.Sprintf("invalid answer for %s: %s", invalid.Key, invalid.Err))
+	}
+	return "huh: " + strings.Join(parts, "; ")
+}
+
+// answerer is implemented by fields that can be completed from an answers
+// source.
+type answerer interface {
+	// answer sets the field's value and validates it.
+	answer(value any) error
+}
+
+// runAnswers completes the form from its answers source.
+func (f *Form) runAnswers() error {
+	var answersErr AnswersError
+
+	// groups are hidden or chosen based on the previous answers, which
+	// have already been set at this point. answers don't change, so a
+	// group is never answered twice.
+	seen := make(map[int]bool)
+	for g := f.firstGroupIndex(); g >= 0 && !seen[g]; g = f.nextGroupIndex(g) {
+		seen[g] = true
+		f.selector.Get(g).selector.Range(func(i int, field Field) bool {
+			if field.Skip() {
+				return true
+			}
+
+			key := field.GetKey()
+			if key == "" {
+				key = fmt.Sprintf("#%d.%d", g, i)
+			}
+
+			a, ok := field.(answerer)
+			if !ok {
+				answersErr.Invalid = append(answersErr.Invalid, InvalidAnswer{
+					Key: key,
+					Err: fmt.Errorf("%T can't be answered", field),
+				})
+				return true
+			}
+
+			// every field is answered, even if its current value would do.
+			value, found := f.answers.Lookup(field.GetKey())
+			if !found || field.GetKey() == "" {
+				answersErr.Missing = append(answersErr.Missing, key)
+				return true
+			}
+			if err := a.answer(value); err != nil {
+				answersErr.Invalid = append(answersErr.Invalid, InvalidAnswer{Key: key, Err: err})
+				return true
+			}
+			f.results[field.GetKey()] = field.GetValue()
+			return true
+		})
+	}
+
+	if len(answersErr.Missing) > 0 || len(answersErr.Invalid) > 0 {
+		f.State = StateAborted
+		return &answersErr
+	}
+	f.State = StateCompleted
+	return nil
+}
+
+// answerString converts an answer to a string.
+func answerString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// answerBool converts an answer to a boolean.
+func answerBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, fmt.Errorf("%q is not a boolean", v)
+		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("%v is not a boolean", v)
+	}
+}
+
+// answerList converts an answer to a list of strings. Strings are split on
+// commas.
+func answerList(value any) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			list = append(list, answerString(item))
+		}
+		return list
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil
+		}
+		list := strings.Split(v, ",")
+		for i := range list {
+			list[i] = strings.TrimSpace(list[i])
+		}
+		return list
+	default:
+		return []string{answerString(v)}
+	}
+}
+
+// answerOption returns the option matching an answer, by value, key or
+// formatted value.
+func answerOption[T comparable](options []Option[T], value any) (Option[T], error) {
+	if v, ok := value.(T); ok {
+		for _, o := range options {
+			if o.Value == v {
+				return o, nil
+			}
+		}
+	}
+	s := answerString(value)
+	for _, o := range options {
+		if o.Key == s || fmt.Sprint(o.Value) == s {
+			return o, nil
+		}
+	}
+	return Option[T]{}, fmt.Errorf("%q is not one of the options", s)
+}
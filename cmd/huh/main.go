@@ -0,0 +1,219 @@
+// Command huh runs a form described by a YAML or JSON spec and prints the
+// results.
+//
+//	huh [flags] <spec.yaml|spec.json|->
+//
+// The form is shown on stderr and the results are printed to stdout as JSON
+// (the default), as env-style KEY=value lines, or as shell-quoted export
+// statements that can be evaluated by a shell:
+//
+//	eval "$(huh --format shell form.yaml)"
+//
+// Env values are double quoted when they hold line breaks, quotes,
+// backslashes, dollar signs, hashes or surrounding spaces, with those escaped.
+//
+// Keys are read from the terminal when stdin is redirected, such as when the
+// spec is piped in with -.
+//
+// A spec lists groups of fields, along with an optional theme and layout:
+//
+//	theme: catppuccin
+//	layout: columns:2
+//	groups:
+//	  - title: Order
+//	    fields:
+//	      - type: input
+//	        key: name
+//	        title: What's your name?
+//	        validate: {required: true, max_length: 32}
+//	      - type: select
+//	        key: shell
+//	        options: [Soft, Hard]
+//	      - type: multiselect
+//	        key: toppings
+//	        options:
+//	          - {key: Lettuce, value: lettuce}
+//	          - {key: Cheese, value: cheese}
+//	  - fields:
+//	      - type: confirm
+//	        key: discount
+//	        title: Use a discount?
+//
+// The supported field types are input, text, select, multiselect, confirm,
+// filepicker and note.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+const usage = `Usage: huh [flags] <spec>
+
+Runs the form described by the YAML or JSON spec, use - to read it from stdin,
+and prints the results to stdout.
+
+Flags:
+`
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("huh", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		_, _ = fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	var (
+		format     = flags.String("format", "json", "output format: json, env or shell")
+		prefix     = flags.String("prefix", "", "prefix for variable names in env and shell formats")
+		theme      = flags.String("theme", "", "theme: base, base16, catppuccin, charm or dracula")
+		accessible = flags.Bool("accessible", false, "run the form in accessible mode")
+		answers    = flags.String("answers", "", "complete the form from a YAML or JSON answers file instead of prompting")
+	)
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	switch *format {
+	case "json", "env", "shell":
+	default:
+		_, _ = fmt.Fprintf(stderr, "huh: unknown format %q\n", *format)
+		return 2
+	}
+
+	spec, err := ReadSpec(flags.Arg(0), stdin)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "huh:", err)
+		return 1
+	}
+	if *theme != "" {
+		spec.Theme = *theme
+	}
+	if *accessible {
+		spec.Accessible = true
+	}
+
+	form, results, err := spec.Build()
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "huh:", err)
+		return 1
+	}
+	// stdout is left for the results, so they can be evaluated.
+	form.WithOutput(stderr)
+	switch {
+	case stdin != os.Stdin:
+		form.WithInput(stdin)
+	case flags.Arg(0) == "-" && *answers == "":
+		// the spec drained stdin, so keys are read from the terminal.
+		in, out, err := tea.OpenTTY()
+		if err != nil {
+			_, _ = fmt.Fprintln(stderr, "huh: could not open the terminal:", err)
+			return 1
+		}
+		defer in.Close()  //nolint:errcheck
+		defer out.Close() //nolint:errcheck
+		form.WithInput(in)
+	}
+	// otherwise, the form falls back to the terminal when stdin isn't one.
+	if *answers != "" {
+		a, err := huh.AnswersFromFile(*answers)
+		if err != nil {
+			_, _ = fmt.Fprintln(stderr, err)
+			return 1
+		}
+		form.WithAnswers(a)
+	}
+
+	if err := form.Run(); err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		if errors.Is(err, huh.ErrUserAborted) {
+			return 130
+		}
+		return 1
+	}
+
+	if err := writeResults(stdout, *format, *prefix, results); err != nil {
+		_, _ = fmt.Fprintln(stderr, "huh:", err)
+		return 1
+	}
+	return 0
+}
+
+// writeResults writes the results in the given format.
+func writeResults(w io.Writer, format, prefix string, results []Result) error {
+	switch format {
+	case "json":
+		m := make(map[string]any, len(results))
+		for _, r := range results {
+			m[r.Key] = r.Value()
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(m) //nolint:wrapcheck
+	case "env":
+		for _, r := range results {
+			_, _ = fmt.Fprintf(w, "%s=%s\n", huh.EnvName(prefix, r.Key), envQuote(formatValue(r.Value())))
+		}
+		return nil
+	case "shell":
+		for _, r := range results {
+			_, _ = fmt.Fprintf(w, "export %s=%s\n", huh.EnvName(prefix, r.Key), shellQuote(formatValue(r.Value())))
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// formatValue formats a value for env and shell outputs. Lists are joined
+// with commas.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// envEscaper escapes the characters env files treat specially within double
+// quotes.
+var envEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	`$`, `\$`,
+	"\n", `\n`,
+	"\r", `\r`,
+)
+
+// envQuote double quotes a value for env files, if it needs it.
+func envQuote(s string) string {
+	if !strings.ContainsAny(s, "\n\r\"\\$#") && strings.TrimSpace(s) == s {
+		return s
+	}
+	return `"` + envEscaper.Replace(s) + `"`
+}
+
+// shellQuote quotes a string for POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSpec = `
+theme: dracula
+layout: columns:2
+groups:
+  - title: Order
+    fields:
+      - type: input
+        key: name
+        title: Name
+        validate:
+          required: true
+          max_length: 12
+      - type: select
+        key: shell
+        options: [Soft, Hard]
+        default: Hard
+      - type: multiselect
+        key: toppings
+        options:
+          - key: Lettuce
+            value: lettuce
+          - cheese
+  - title: Extras
+    fields:
+      - type: note
+        title: Almost done
+      - type: confirm
+        key: discount
+`
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestParseSpec(t *testing.T) {
+	spec, err := ParseSpec([]byte(testSpec), "form.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Groups) != 2 || len(spec.Groups[0].Fields) != 3 {
+		t.Fatalf("unexpected spec: %+v", spec)
+	}
+	options := spec.Groups[0].Fields[2].Options
+	if options[0].Key != "Lettuce" || options[0].Value != "lettuce" || options[1].Value != "cheese" {
+		t.Errorf("unexpected options: %+v", options)
+	}
+
+	spec, err = ParseSpec([]byte(`{"groups": [{"fields": [{"type": "select", "key": "a", "options": ["x", {"key": "Y", "value": "y"}]}]}]}`), "form.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options := spec.Groups[0].Fields[0].Options; options[0].Value != "x" || options[1].Key != "Y" {
+		t.Errorf("unexpected options: %+v", options)
+	}
+
+	if _, err := ParseSpec([]byte("groups: []"), "form.yaml"); err == nil {
+		t.Error("expected an error for a spec without groups")
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	for name, spec := range map[string]string{
+		"missing key":    `groups: [{fields: [{type: input}]}]`,
+		"unknown type":   `groups: [{fields: [{type: slider, key: a}]}]`,
+		"no options":     `groups: [{fields: [{type: select, key: a}]}]`,
+		"duplicate key":  `groups: [{fields: [{key: a}, {key: a}]}]`,
+		"bad pattern":    `groups: [{fields: [{key: a, validate: {pattern: "("}}]}]`,
+		"unknown layout": "layout: spiral\ngroups: [{fields: [{key: a}]}]",
+		"unknown theme":  "theme: neon\ngroups: [{fields: [{key: a}]}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			s, err := ParseSpec([]byte(spec), "form.yaml")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := s.Build(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	spec := writeFile(t, "form.yaml", testSpec)
+	answers := writeFile(t, "answers.yaml", "name: Carl O'Neil\nshell: Hard\ntoppings: [Lettuce, cheese]\ndiscount: true\n")
+
+	for format, want := range map[string]string{
+		"json": `{
+  "discount": true,
+  "name": "Carl O'Neil",
+  "shell": "Hard",
+  "toppings": [
+    "lettuce",
+    "cheese"
+  ]
+}
+`,
+		"env": "NAME=Carl O'Neil\nSHELL=Hard\nTOPPINGS=lettuce,cheese\nDISCOUNT=true\n",
+		"shell": `export NAME='Carl O'\''Neil'
+export SHELL='Hard'
+export TOPPINGS='lettuce,cheese'
+export DISCOUNT='true'
+`,
+	} {
+		t.Run(format, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run([]string{"-format", format, "-answers", answers, spec}, nil, &stdout, &stderr)
+			if code != 0 {
+				t.Fatalf("exit code %d: %s", code, stderr.String())
+			}
+			if got := stdout.String(); got != want {
+				t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+			}
+		})
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-format", "env", "-prefix", "order-", "-answers", answers, spec}, nil, &stdout, &stderr)
+	if code != 0 || !strings.HasPrefix(stdout.String(), "ORDER_NAME=") {
+		t.Errorf("expected prefixed names, got %d %q", code, stdout.String())
+	}
+
+	// the spec is read from the given stdin.
+	stdout.Reset()
+	stderr.Reset()
+	code = run([]string{"-answers", answers, "-"}, strings.NewReader(testSpec), &stdout, &stderr)
+	if code != 0 || !strings.Contains(stdout.String(), `"name": "Carl O'Neil"`) {
+		t.Errorf("expected the results of the spec read from stdin, got %d %q %q", code, stdout.String(), stderr.String())
+	}
+
+	// unknown formats are reported before the form runs.
+	stdout.Reset()
+	stderr.Reset()
+	if code := run([]string{"-format", "xml", spec}, strings.NewReader(""), &stdout, &stderr); code != 2 {
+		t.Errorf("expected exit code 2, got %d", code)
+	}
+	if stderr.String() != "huh: unknown format \"xml\"\n" {
+		t.Errorf("expected only an unknown format error, got %q", stderr.String())
+	}
+
+	missing := writeFile(t, "missing.yaml", "shell: Soft\n")
+	stdout.Reset()
+	stderr.Reset()
+	if code := run([]string{"-answers", missing, spec}, nil, &stdout, &stderr); code != 1 {
+		t.Errorf("expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "missing answers for name") {
+		t.Errorf("expected a missing answer error, got %q", stderr.String())
+	}
+}
+
+func TestRunAccessible(t *testing.T) {
+	spec := writeFile(t, "form.yaml", "groups: [{fields: [{type: input, key: name, title: Name}]}]")
+
+	// the prompts go to stderr, so stdout only has the results.
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader("Carl \"C\" $5\n")
+	code := run([]string{"-accessible", "-format", "env", "-prefix", "order", spec}, stdin, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr.String())
+	}
+	if want := `ORDER_NAME="Carl \"C\" \$5"` + "\n"; stdout.String() != want {
+		t.Errorf("expected %q, got %q", want, stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "Name") {
+		t.Errorf("expected the prompt on stderr, got %q", stderr.String())
+	}
+}
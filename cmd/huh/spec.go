@@ -0,0 +1,414 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"charm.land/huh/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Spec describes a form.
+type Spec struct {
+	Theme      string      `yaml:"theme" json:"theme"`
+	Layout     string      `yaml:"layout" json:"layout"`
+	Accessible bool        `yaml:"accessible" json:"accessible"`
+	Groups     []GroupSpec `yaml:"groups" json:"groups"`
+}
+
+// GroupSpec describes a group of fields.
+type GroupSpec struct {
+	Title       string      `yaml:"title" json:"title"`
+	Description string      `yaml:"description" json:"description"`
+	Fields      []FieldSpec `yaml:"fields" json:"fields"`
+}
+
+// FieldSpec describes a single field.
+type FieldSpec struct {
+	Type        string       `yaml:"type" json:"type"`
+	Key         string       `yaml:"key" json:"key"`
+	Title       string       `yaml:"title" json:"title"`
+	Description string       `yaml:"description" json:"description"`
+	Placeholder string       `yaml:"placeholder" json:"placeholder"`
+	Default     any          `yaml:"default" json:"default"`
+	Options     []OptionSpec `yaml:"options" json:"options"`
+	Password    bool         `yaml:"password" json:"password"`
+	Inline      bool         `yaml:"inline" json:"inline"`
+	CharLimit   int          `yaml:"char_limit" json:"char_limit"`
+	Limit       int          `yaml:"limit" json:"limit"`
+	Height      int          `yaml:"height" json:"height"`
+	Affirmative string       `yaml:"affirmative" json:"affirmative"`
+	Negative    string       `yaml:"negative" json:"negative"`
+	Validate    ValidateSpec `yaml:"validate" json:"validate"`
+}
+
+// ValidateSpec describes a field's validation rules.
+type ValidateSpec struct {
+	Required  bool   `yaml:"required" json:"required"`
+	MinLength int    `yaml:"min_length" json:"min_length"`
+	MaxLength int    `yaml:"max_length" json:"max_length"`
+	Pattern   string `yaml:"pattern" json:"pattern"`
+}
+
+// OptionSpec is a select option. It can be written either as a plain string,
+// used as both the label and the value, or as an object with a key and a
+// value.
+type OptionSpec struct {
+	Key   string `yaml:"key" json:"key"`
+	Value string `yaml:"value" json:"value"`
+}
+
+// UnmarshalYAML implements [yaml.Unmarshaler].
+func (o *OptionSpec) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		o.Key, o.Value = node.Value, node.Value
+		return nil
+	}
+	type option OptionSpec
+	if err := node.Decode((*option)(o)); err != nil {
+		return err //nolint:wrapcheck
+	}
+	o.Value = cmp.Or(o.Value, o.Key)
+	o.Key = cmp.Or(o.Key, o.Value)
+	return nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (o *OptionSpec) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		o.Key, o.Value = s, s
+		return nil
+	}
+	type option OptionSpec
+	if err := json.Unmarshal(data, (*option)(o)); err != nil {
+		return err //nolint:wrapcheck
+	}
+	o.Value = cmp.Or(o.Value, o.Key)
+	o.Key = cmp.Or(o.Key, o.Value)
+	return nil
+}
+
+// ParseSpec parses a spec from a JSON or YAML document.
+func ParseSpec(data []byte, name string) (*Spec, error) {
+	var (
+		spec Spec
+		err  error
+	)
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		err = json.Unmarshal(data, &spec)
+	} else {
+		err = yaml.Unmarshal(data, &spec)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", name, err)
+	}
+	if len(spec.Groups) == 0 {
+		return nil, fmt.Errorf("%s: no groups defined", name)
+	}
+	return &spec, nil
+}
+
+// ReadSpec reads a spec from a file, or from stdin if the path is "-".
+func ReadSpec(path string, stdin io.Reader) (*Spec, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path) //nolint:gosec
+	}
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return ParseSpec(data, path)
+}
+
+// Result is a field's value, bound to the field when the form is built.
+type Result struct {
+	Key   string
+	value func() any
+}
+
+// Value returns the current value of the field.
+func (r Result) Value() any {
+	return r.value()
+}
+
+// Build builds the form described by the spec, returning it along with the
+// results of its fields in order.
+func (s *Spec) Build() (*huh.Form, []Result, error) {
+	var (
+		groups  = make([]*huh.Group, 0, len(s.Groups))
+		results []Result
+		keys    = make(map[string]bool)
+	)
+	for g, gs := range s.Groups {
+		fields := make([]huh.Field, 0, len(gs.Fields))
+		for i, fs := range gs.Fields {
+			field, result, err := fs.build()
+			if err != nil {
+				return nil, nil, fmt.Errorf("group %d, field %d: %w", g+1, i+1, err)
+			}
+			fields = append(fields, field)
+			if result == nil {
+				continue
+			}
+			if keys[result.Key] {
+				return nil, nil, fmt.Errorf("group %d, field %d: duplicate key %q", g+1, i+1, result.Key)
+			}
+			keys[result.Key] = true
+			results = append(results, *result)
+		}
+		groups = append(groups, huh.NewGroup(fields...).
+			Title(gs.Title).
+			Description(gs.Description))
+	}
+
+	form := huh.NewForm(groups...).WithAccessible(s.Accessible)
+	if s.Layout != "" {
+		layout, err := parseLayout(s.Layout)
+		if err != nil {
+			return nil, nil, err
+		}
+		form.WithLayout(layout)
+	}
+	if s.Theme != "" {
+		theme, err := parseTheme(s.Theme)
+		if err != nil {
+			return nil, nil, err
+		}
+		form.WithTheme(theme)
+	}
+	return form, results, nil
+}
+
+func (fs FieldSpec) build() (huh.Field, *Result, error) {
+	if fs.Type != "note" && fs.Key == "" {
+		return nil, nil, fmt.Errorf("%s field requires a key", cmp.Or(fs.Type, "input"))
+	}
+	title := cmp.Or(fs.Title, fs.Key)
+
+	switch cmp.Or(fs.Type, "input") {
+	case "input":
+		value := defaultString(fs.Default)
+		validate, err := fs.Validate.stringValidator()
+		if err != nil {
+			return nil, nil, err
+		}
+		input := huh.NewInput().
+			Key(fs.Key).
+			Title(title).
+			Description(fs.Description).
+			Placeholder(fs.Placeholder).
+			Inline(fs.Inline).
+			CharLimit(fs.CharLimit).
+			Validate(validate).
+			Value(&value)
+		if fs.Password {
+			input.EchoMode(huh.EchoModePassword)
+		}
+		return input, &Result{Key: fs.Key, value: func() any { return value }}, nil
+
+	case "text":
+		value := defaultString(fs.Default)
+		validate, err := fs.Validate.stringValidator()
+		if err != nil {
+			return nil, nil, err
+		}
+		text := huh.NewText().
+			Key(fs.Key).
+			Title(title).
+			Description(fs.Description).
+			Placeholder(fs.Placeholder).
+			CharLimit(fs.CharLimit).
+			Validate(validate).
+			Value(&value)
+		return text, &Result{Key: fs.Key, value: func() any { return value }}, nil
+
+	case "select":
+		if len(fs.Options) == 0 {
+			return nil, nil, errors.New("select field requires options")
+		}
+		value := defaultString(fs.Default)
+		sel := huh.NewSelect[string]().
+			Key(fs.Key).
+			Title(title).
+			Description(fs.Description).
+			Options(fs.options()...).
+			Inline(fs.Inline).
+			Value(&value)
+		if fs.Height > 0 {
+			sel.Height(fs.Height)
+		}
+		return sel, &Result{Key: fs.Key, value: func() any { return value }}, nil
+
+	case "multiselect":
+		if len(fs.Options) == 0 {
+			return nil, nil, errors.New("multiselect field requires options")
+		}
+		var value []string
+		if defaults, ok := fs.Default.([]any); ok {
+			for _, d := range defaults {
+				value = append(value, defaultString(d))
+			}
+		}
+		required := fs.Validate.Required
+		ms := huh.NewMultiSelect[string]().
+			Key(fs.Key).
+			Title(title).
+			Description(fs.Description).
+			Options(fs.options()...).
+			Limit(fs.Limit).
+			Validate(func(s []string) error {
+				if required && len(s) == 0 {
+					return errors.New("select at least one option")
+				}
+				return nil
+			}).
+			Value(&value)
+		if fs.Height > 0 {
+			ms.Height(fs.Height)
+		}
+		return ms, &Result{Key: fs.Key, value: func() any { return value }}, nil
+
+	case "confirm":
+		value, _ := fs.Default.(bool)
+		confirm := huh.NewConfirm().
+			Key(fs.Key).
+			Title(title).
+			Description(fs.Description).
+			Inline(fs.Inline).
+			Affirmative(cmp.Or(fs.Affirmative, "Yes")).
+			Negative(cmp.Or(fs.Negative, "No")).
+			Value(&value)
+		return confirm, &Result{Key: fs.Key, value: func() any { return value }}, nil
+
+	case "filepicker":
+		value := defaultString(fs.Default)
+		validate, err := fs.Validate.stringValidator()
+		if err != nil {
+			return nil, nil, err
+		}
+		picker := huh.NewFilePicker().
+			Key(fs.Key).
+			Title(title).
+			Description(fs.Description).
+			Validate(validate).
+			Value(&value)
+		if fs.Height > 0 {
+			picker.Height(fs.Height)
+		}
+		return picker, &Result{Key: fs.Key, value: func() any { return value }}, nil
+
+	case "note":
+		return huh.NewNote().
+			Title(fs.Title).
+			Description(fs.Description), nil, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown field type %q", fs.Type)
+	}
+}
+
+func (fs FieldSpec) options() []huh.Option[string] {
+	options := make([]huh.Option[string], 0, len(fs.Options))
+	for _, o := range fs.Options {
+		options = append(options, huh.NewOption(o.Key, o.Value))
+	}
+	return options
+}
+
+func (v ValidateSpec) stringValidator() (func(string) error, error) {
+	var re *regexp.Regexp
+	if v.Pattern != "" {
+		var err error
+		re, err = regexp.Compile(v.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+	return func(s string) error {
+		if v.Required {
+			if err := huh.ValidateNotEmpty()(s); err != nil {
+				return err
+			}
+		}
+		if s == "" && !v.Required {
+			return nil
+		}
+		if v.MinLength > 0 {
+			if err := huh.ValidateMinLength(v.MinLength)(s); err != nil {
+				return err
+			}
+		}
+		if v.MaxLength > 0 {
+			if err := huh.ValidateMaxLength(v.MaxLength)(s); err != nil {
+				return err
+			}
+		}
+		if re != nil && !re.MatchString(s) {
+			return fmt.Errorf("input must match %s", v.Pattern)
+		}
+		return nil
+	}, nil
+}
+
+func defaultString(v any) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func parseLayout(s string) (huh.Layout, error) {
+	name, arg, _ := strings.Cut(s, ":")
+	switch name {
+	case "default":
+		return huh.LayoutDefault, nil
+	case "stack":
+		return huh.LayoutStack, nil
+	case "columns":
+		n, err := strconv.Atoi(arg)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid columns layout %q, expected columns:N", s)
+		}
+		return huh.LayoutColumns(n), nil
+	case "grid":
+		rows, columns, _ := strings.Cut(arg, "x")
+		r, rerr := strconv.Atoi(rows)
+		c, cerr := strconv.Atoi(columns)
+		if rerr != nil || cerr != nil || r <= 0 || c <= 0 {
+			return nil, fmt.Errorf("invalid grid layout %q, expected grid:RxC", s)
+		}
+		return huh.LayoutGrid(r, c), nil
+	default:
+		return nil, fmt.Errorf("unknown layout %q", s)
+	}
+}
+
+var themes = map[string]huh.ThemeFunc{
+	"base":       huh.ThemeBase,
+	"base16":     huh.ThemeBase16,
+	"catppuccin": huh.ThemeCatppuccin,
+	"charm":      huh.ThemeCharm,
+	"dracula":    huh.ThemeDracula,
+}
+
+func parseTheme(s string) (huh.Theme, error) {
+	theme, ok := themes[strings.ToLower(s)]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %q", s)
+	}
+	return theme, nil
+}
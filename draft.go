@@ -0,0 +1,194 @@
+package huh
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Draft is a partially completed form: the values answered so far, by field
+// key, and the position of the field to resume from.
+type Draft struct {
+	Group  int            `json:"group"`
+	Field  int            `json:"field"`
+	Values map[string]any `json:"values"`
+}
+
+// DraftStore stores the draft of a form.
+//
+// Load returns a nil draft, and no error, if there's no draft.
+type DraftStore interface {
+	Load() (*Draft, error)
+	Save(draft *Draft) error
+	Delete() error
+}
+
+// DraftFile is a [DraftStore] backed by a JSON file.
+type DraftFile struct {
+	path string
+}
+
+// NewDraftFile returns a draft store backed by the JSON file at the given
+// path. The file is created when the first draft is saved.
+func NewDraftFile(path string) *DraftFile {
+	return &DraftFile{path: path}
+}
+
+// Load reads the draft from the file.
+func (d *DraftFile) Load() (*Draft, error) {
+	data, err := os.ReadFile(d.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	var draft Draft
+	if err := json.Unmarshal(data, &draft); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return &draft, nil
+}
+
+// Save writes the draft to the file, replacing it atomically.
+func (d *DraftFile) Save(draft *Draft) error {
+	data, err := json.Marshal(draft)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	if err := os.MkdirAll(filepath.Dir(d.path), 0o700); err != nil { //nolint:mnd
+		return err //nolint:wrapcheck
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(d.path), filepath.Base(d.path)+".*")
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err //nolint:wrapcheck
+	}
+	if err := tmp.Close(); err != nil {
+		return err //nolint:wrapcheck
+	}
+	return os.Rename(tmp.Name(), d.path) //nolint:wrapcheck
+}
+
+// Delete removes the file, if it exists.
+func (d *DraftFile) Delete() error {
+	if err := os.Remove(d.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err //nolint:wrapcheck
+	}
+	return nil
+}
+
+// saveDraft saves the values answered so far and the current position, if
+// the form has a draft store. Errors are ignored, a draft is best effort.
+func (f *Form) saveDraft() {
+	if f.draft == nil || f.selector.Empty() {
+		return
+	}
+	draft := &Draft{
+		Group:  f.selector.Index(),
+		Field:  f.selector.Selected().selector.Index(),
+		Values: make(map[string]any, len(f.results)),
+	}
+	f.selector.Range(func(_ int, group *Group) bool {
+		group.selector.Range(func(_ int, field Field) bool {
+			value, ok := f.results[field.GetKey()]
+			if !ok || field.GetKey() == "" || isSensitive(field) {
+				return true
+			}
+			draft.Values[field.GetKey()] = value
+			return true
+		})
+		return true
+	})
+	_ = f.draft.Save(draft)
+}
+
+// sensitiveField is a field whose value may be a password or a secret, which
+// is never written to disk.
+type sensitiveField interface {
+	sensitive() bool
+}
+
+// isSensitive returns whether the value of a field is never written to disk.
+func isSensitive(field Field) bool {
+	s, ok := field.(sensitiveField)
+	return ok && s.sensitive()
+}
+
+// resumeDraft offers to resume from the stored draft, if there's one, and
+// restores its values and position.
+func (f *Form) resumeDraft(w io.Writer, r io.Reader) error {
+	draft, err := f.draft.Load()
+	if err != nil || draft == nil {
+		return err
+	}
+
+	resume := true
+	confirm := NewForm(NewGroup(
+		NewConfirm().
+			Title("Resume where you left off?").
+			Affirmative("Resume").
+			Negative("Start over").
+			Value(&resume),
+	)).
+		WithTheme(f.theme).
+		WithAccessible(f.accessible).
+		WithOutput(w).
+		WithInput(r).
+		WithProgramOptions(f.teaOptions...)
+	if err := confirm.Run(); err != nil {
+		return err
+	}
+	if !resume {
+		return f.draft.Delete()
+	}
+
+	f.restoreDraft(draft)
+	return nil
+}
+
+// restoreDraft sets the fields' values and the form's position from the
+// draft.
+func (f *Form) restoreDraft(draft *Draft) {
+	f.selector.Range(func(_ int, group *Group) bool {
+		group.selector.Range(func(_ int, field Field) bool {
+			value, ok := draft.Values[field.GetKey()]
+			a, answerable := field.(answerer)
+			if !ok || !answerable || field.GetKey() == "" {
+				return true
+			}
+			// values that no longer fit the field are dropped.
+			if err := a.answer(value); err == nil {
+				f.results[field.GetKey()] = field.GetValue()
+			}
+			return true
+		})
+		return true
+	})
+
+	if draft.Group < 0 || draft.Group >= f.selector.Total() {
+		return
+	}
+	group := f.selector.Get(draft.Group)
+	if draft.Field < 0 || draft.Field >= group.selector.Total() {
+		return
+	}
+
+	// going back retraces the path to the resumed group.
+	f.history = nil
+	for _, i := range f.path() {
+		if i == draft.Group {
+			break
+		}
+		f.history = append(f.history, i)
+	}
+	f.selector.SetIndex(draft.Group)
+	group.selector.SetIndex(draft.Field)
+}
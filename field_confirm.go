@@ -15,6 +15,7 @@ import (
 type Confirm struct {
 	accessor Accessor[bool]
 	key      string
+	hide     func() bool
 	id       int
 
 	// customization
@@ -66,10 +67,25 @@ func (c *Confirm) Error() error {
 }
 
 // Skip returns whether the confirm should be skipped or should be blocking.
-func (*Confirm) Skip() bool {
-	return false
+func (c *Confirm) Skip() bool { return c.hidden() }
+
+// Hide sets whether the confirm is hidden.
+func (c *Confirm) Hide(hide bool) *Confirm {
+	return c.HideFunc(func() bool { return hide })
 }
 
+// HideFunc sets the function that checks whether the confirm is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (c *Confirm) HideFunc(hideFunc func() bool) *Confirm {
+	c.hide = hideFunc
+	return c
+}
+
+// hidden returns whether the confirm is hidden.
+func (c *Confirm) hidden() bool { return c.hide != nil && c.hide() }
+
 // Zoom returns whether the input should be zoomed.
 func (*Confirm) Zoom() bool {
 	return false
@@ -322,6 +338,24 @@ func (c *Confirm) String() string {
 	return c.negative
 }
 
+// answer sets the value of the confirm field from an answers source.
+func (c *Confirm) answer(value any) error {
+	b, err := answerBool(value)
+	if err != nil {
+		return err
+	}
+	c.accessor.Set(b)
+	return c.validate(c.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (c *Confirm) review() (string, string) {
+	if c.accessor.Get() {
+		return c.title.val, c.affirmative
+	}
+	return c.title.val, c.negative
+}
+
 // WithTheme sets the theme of the confirm field.
 func (c *Confirm) WithTheme(theme Theme) Field {
 	if c.theme != nil {
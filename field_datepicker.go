@@ -0,0 +1,685 @@
+package huh
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+const (
+	// DateLayout is the default layout of date pickers.
+	DateLayout = "2006-01-02"
+
+	// DateTimeLayout is the default layout of date pickers with a time of day.
+	DateTimeLayout = "2006-01-02 15:04"
+
+	daysPerWeek = 7
+)
+
+// time of day segments.
+const (
+	hourSegment = iota
+	minuteSegment
+)
+
+// DatePicker is a form field to pick a date, and optionally a time of day, on
+// a month calendar.
+type DatePicker struct {
+	accessor Accessor[time.Time]
+	key      string
+	hide     func() bool
+	id       int
+
+	// customization
+	title        Eval[string]
+	description  Eval[string]
+	layout       string
+	firstWeekday time.Weekday
+	withTime     bool
+
+	// bounds
+	minimum *time.Time
+	maximum *time.Time
+
+	// error handling
+	validate func(time.Time) error
+	err      error
+
+	// state
+	cursor    time.Time // the day under the cursor, at midnight
+	hour      int
+	minute    int
+	timeFocus bool // whether the time of day is being picked
+	segment   int
+	focused   bool
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    DatePickerKeyMap
+}
+
+// NewDatePicker returns a new date picker field.
+func NewDatePicker() *DatePicker {
+	d := &DatePicker{
+		accessor:     &EmbeddedAccessor[time.Time]{},
+		id:           nextID(),
+		title:        Eval[string]{cache: make(map[uint64]string)},
+		description:  Eval[string]{cache: make(map[uint64]string)},
+		firstWeekday: time.Sunday,
+		validate:     func(time.Time) error { return nil },
+	}
+	d.load()
+	return d
+}
+
+// Value sets the value of the date picker.
+func (d *DatePicker) Value(value *time.Time) *DatePicker {
+	return d.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the date picker.
+func (d *DatePicker) Accessor(accessor Accessor[time.Time]) *DatePicker {
+	d.accessor = accessor
+	d.load()
+	return d
+}
+
+// Key sets the key of the date picker.
+func (d *DatePicker) Key(key string) *DatePicker {
+	d.key = key
+	return d
+}
+
+// Title sets the title of the date picker.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (d *DatePicker) Title(title string) *DatePicker {
+	d.title.val = title
+	d.title.fn = nil
+	return d
+}
+
+// TitleFunc sets the title func of the date picker.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (d *DatePicker) TitleFunc(f func() string, bindings any) *DatePicker {
+	d.title.fn = f
+	d.title.bindings = bindings
+	return d
+}
+
+// Description sets the description of the date picker.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (d *DatePicker) Description(description string) *DatePicker {
+	d.description.val = description
+	d.description.fn = nil
+	return d
+}
+
+// DescriptionFunc sets the description func of the date picker.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (d *DatePicker) DescriptionFunc(f func() string, bindings any) *DatePicker {
+	d.description.fn = f
+	d.description.bindings = bindings
+	return d
+}
+
+// Time sets whether a time of day is picked after the date.
+func (d *DatePicker) Time(v bool) *DatePicker {
+	d.withTime = v
+	return d
+}
+
+// Min sets the earliest date and time allowed.
+func (d *DatePicker) Min(t time.Time) *DatePicker {
+	d.minimum = &t
+	d.cursor = d.clamp(d.cursor)
+	return d
+}
+
+// Max sets the latest date and time allowed.
+func (d *DatePicker) Max(t time.Time) *DatePicker {
+	d.maximum = &t
+	d.cursor = d.clamp(d.cursor)
+	return d
+}
+
+// FirstWeekday sets the day weeks start on. Defaults to Sunday.
+func (d *DatePicker) FirstWeekday(day time.Weekday) *DatePicker {
+	d.firstWeekday = day
+	return d
+}
+
+// Layout sets the layout used to show the value and, in accessible mode, to
+// parse typed dates. Defaults to [DateLayout], or [DateTimeLayout] if a time
+// of day is picked.
+func (d *DatePicker) Layout(layout string) *DatePicker {
+	d.layout = layout
+	return d
+}
+
+// Validate sets the validation function of the date picker.
+func (d *DatePicker) Validate(validate func(time.Time) error) *DatePicker {
+	d.validate = validate
+	return d
+}
+
+// Error returns the error of the date picker.
+func (d *DatePicker) Error() error { return d.err }
+
+// Skip returns whether the date picker should be skipped or should be blocking.
+func (d *DatePicker) Skip() bool { return d.hidden() }
+
+// Hide sets whether the date picker is hidden.
+func (d *DatePicker) Hide(hide bool) *DatePicker {
+	return d.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the date picker is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (d *DatePicker) HideFunc(hideFunc func() bool) *DatePicker {
+	d.hide = hideFunc
+	return d
+}
+
+// hidden returns whether the date picker is hidden.
+func (d *DatePicker) hidden() bool { return d.hide != nil && d.hide() }
+
+// Zoom returns whether the date picker should be zoomed.
+func (*DatePicker) Zoom() bool { return false }
+
+// Focus focuses the date picker.
+func (d *DatePicker) Focus() tea.Cmd {
+	d.focused = true
+	d.setTimeFocus(false)
+	return nil
+}
+
+// Blur blurs the date picker.
+func (d *DatePicker) Blur() tea.Cmd {
+	d.focused = false
+	d.accessor.Set(d.value())
+	d.err = d.check(d.value())
+	return nil
+}
+
+// KeyBinds returns the help message for the date picker.
+func (d *DatePicker) KeyBinds() []key.Binding {
+	return []key.Binding{
+		d.keymap.Left, d.keymap.Right, d.keymap.Up, d.keymap.Down,
+		d.keymap.PrevMonth, d.keymap.NextMonth,
+		d.keymap.Prev, d.keymap.Submit, d.keymap.Next,
+	}
+}
+
+// Init initializes the date picker.
+func (d *DatePicker) Init() tea.Cmd {
+	return nil
+}
+
+// Update updates the date picker.
+func (d *DatePicker) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		d.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		if ok, hash := d.title.shouldUpdate(); ok {
+			d.title.bindingsHash = hash
+			if !d.title.loadFromCache() {
+				d.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: d.id, title: d.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := d.description.shouldUpdate(); ok {
+			d.description.bindingsHash = hash
+			if !d.description.loadFromCache() {
+				d.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: d.id, description: d.description.fn(), hash: hash}
+				})
+			}
+		}
+	case updateTitleMsg:
+		if msg.id == d.id && msg.hash == d.title.bindingsHash {
+			d.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == d.id && msg.hash == d.description.bindingsHash {
+			d.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		d.err = nil
+		if d.timeFocus {
+			cmds = append(cmds, d.updateTime(msg))
+			break
+		}
+		switch {
+		case key.Matches(msg, d.keymap.Prev):
+			cmds = append(cmds, PrevField)
+		case key.Matches(msg, d.keymap.Next, d.keymap.Submit):
+			if d.withTime {
+				d.setTimeFocus(true)
+				break
+			}
+			cmds = append(cmds, d.next())
+		case key.Matches(msg, d.keymap.Left):
+			d.moveCursor(d.cursor.AddDate(0, 0, -1))
+		case key.Matches(msg, d.keymap.Right):
+			d.moveCursor(d.cursor.AddDate(0, 0, 1))
+		case key.Matches(msg, d.keymap.Up):
+			d.moveCursor(d.cursor.AddDate(0, 0, -daysPerWeek))
+		case key.Matches(msg, d.keymap.Down):
+			d.moveCursor(d.cursor.AddDate(0, 0, daysPerWeek))
+		case key.Matches(msg, d.keymap.PrevMonth):
+			d.moveCursor(addMonths(d.cursor, -1))
+		case key.Matches(msg, d.keymap.NextMonth):
+			d.moveCursor(addMonths(d.cursor, 1))
+		}
+	}
+
+	return d, tea.Batch(cmds...)
+}
+
+// updateTime handles key presses while the time of day is being picked.
+func (d *DatePicker) updateTime(msg tea.KeyPressMsg) tea.Cmd {
+	// going back to the date works on the first field too.
+	back := d.keymap.Prev
+	back.SetEnabled(true)
+
+	switch {
+	case key.Matches(msg, back):
+		d.setTimeFocus(false)
+	case key.Matches(msg, d.keymap.Next, d.keymap.Submit):
+		return d.next()
+	case key.Matches(msg, d.keymap.Left):
+		d.segment = hourSegment
+	case key.Matches(msg, d.keymap.Right):
+		d.segment = minuteSegment
+	case key.Matches(msg, d.keymap.Up):
+		d.stepTime(1)
+	case key.Matches(msg, d.keymap.Down):
+		d.stepTime(-1)
+	}
+	return nil
+}
+
+// next validates the value and moves on to the next field.
+func (d *DatePicker) next() tea.Cmd {
+	value := d.value()
+	d.accessor.Set(value)
+	if d.err = d.check(value); d.err != nil {
+		return nil
+	}
+	return NextField
+}
+
+// setTimeFocus switches between picking the date and the time of day, and
+// updates the help to match.
+func (d *DatePicker) setTimeFocus(v bool) {
+	d.timeFocus = v
+	d.segment = hourSegment
+	if v {
+		d.keymap.Left.SetHelp("←", "hour")
+		d.keymap.Right.SetHelp("→", "minute")
+		d.keymap.Up.SetHelp("↑", "later")
+		d.keymap.Down.SetHelp("↓", "earlier")
+	} else {
+		d.keymap.Left.SetHelp("←", "prev day")
+		d.keymap.Right.SetHelp("→", "next day")
+		d.keymap.Up.SetHelp("↑", "prev week")
+		d.keymap.Down.SetHelp("↓", "next week")
+	}
+	d.keymap.PrevMonth.SetEnabled(!v)
+	d.keymap.NextMonth.SetEnabled(!v)
+}
+
+// stepTime changes the selected segment of the time of day.
+func (d *DatePicker) stepTime(delta int) {
+	switch d.segment {
+	case hourSegment:
+		d.hour = (d.hour + delta + 24) % 24 //nolint:mnd
+	case minuteSegment:
+		d.minute = (d.minute + delta + 60) % 60 //nolint:mnd
+	}
+	d.accessor.Set(d.value())
+}
+
+// moveCursor moves the cursor to the given day, within the bounds.
+func (d *DatePicker) moveCursor(t time.Time) {
+	d.cursor = d.clamp(t)
+	d.accessor.Set(d.value())
+}
+
+// load sets the cursor and the time of day from the value, defaulting to now.
+func (d *DatePicker) load() {
+	t := d.accessor.Get()
+	if t.IsZero() {
+		t = time.Now()
+	}
+	d.cursor = d.clamp(startOfDay(t))
+	d.hour, d.minute = t.Hour(), t.Minute()
+}
+
+// value returns the date and time under the cursor.
+func (d *DatePicker) value() time.Time {
+	if !d.withTime {
+		return d.cursor
+	}
+	return time.Date(d.cursor.Year(), d.cursor.Month(), d.cursor.Day(), d.hour, d.minute, 0, 0, d.cursor.Location())
+}
+
+// clamp returns the day within the bounds.
+func (d *DatePicker) clamp(t time.Time) time.Time {
+	return clampDay(t, d.minimum, d.maximum)
+}
+
+// selectable returns whether the day is within the bounds.
+func (d *DatePicker) selectable(t time.Time) bool {
+	return d.clamp(t).Equal(t)
+}
+
+// check checks the value against the bounds and the validation function.
+//
+// Without a time of day, only the days of the bounds are compared.
+func (d *DatePicker) check(t time.Time) error {
+	if d.minimum != nil {
+		minimum := *d.minimum
+		if !d.withTime {
+			minimum = startOfDay(minimum)
+		}
+		if t.Before(minimum) {
+			return fmt.Errorf("must be on or after %s", d.format(minimum))
+		}
+	}
+	if d.maximum != nil {
+		maximum := *d.maximum
+		if !d.withTime {
+			maximum = startOfDay(maximum)
+		}
+		if t.After(maximum) {
+			return fmt.Errorf("must be on or before %s", d.format(maximum))
+		}
+	}
+	return d.validate(t)
+}
+
+// getLayout returns the layout of the value.
+func (d *DatePicker) getLayout() string {
+	if d.layout != "" {
+		return d.layout
+	}
+	if d.withTime {
+		return DateTimeLayout
+	}
+	return DateLayout
+}
+
+func (d *DatePicker) format(t time.Time) string {
+	return t.Format(d.getLayout())
+}
+
+// parse parses a date with the layout, or as RFC 3339, as saved in drafts.
+func (d *DatePicker) parse(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	t, err := time.ParseInLocation(d.getLayout(), s, time.Local)
+	if err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("must be a date like %s", time.Now().Format(d.getLayout()))
+}
+
+// set sets the value, and the cursor and time of day to match.
+func (d *DatePicker) set(t time.Time) {
+	d.accessor.Set(t)
+	d.cursor = startOfDay(t)
+	d.hour, d.minute = t.Hour(), t.Minute()
+}
+
+func (d *DatePicker) activeStyles() *FieldStyles {
+	theme := d.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if d.focused {
+		return &theme.Theme(d.hasDarkBg).Focused
+	}
+	return &theme.Theme(d.hasDarkBg).Blurred
+}
+
+// View renders the date picker.
+func (d *DatePicker) View() string {
+	styles := d.activeStyles()
+	maxWidth := d.width - styles.Base.GetHorizontalFrameSize()
+
+	var sb strings.Builder
+	if d.title.val != "" || d.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(d.title.val, maxWidth)))
+		if d.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if d.description.val != "" || d.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(d.description.val, maxWidth)))
+		sb.WriteString("\n")
+	}
+	sb.WriteString(renderCalendar(styles, d.cursor, d.firstWeekday, func(day time.Time) lipgloss.Style {
+		switch {
+		case day.Equal(d.cursor) && !d.timeFocus:
+			return styles.CalendarCursor
+		case day.Equal(d.cursor):
+			return styles.CalendarSelected
+		case !d.selectable(day):
+			return styles.CalendarDisabled
+		case day.Equal(startOfDay(time.Now())):
+			return styles.CalendarToday
+		default:
+			return styles.CalendarDay
+		}
+	}))
+
+	if d.withTime {
+		hour := fmt.Sprintf("%02d", d.hour)
+		minute := fmt.Sprintf("%02d", d.minute)
+		hourStyle, minuteStyle := styles.CalendarDay, styles.CalendarDay
+		if d.timeFocus && d.segment == hourSegment {
+			hourStyle = styles.CalendarCursor
+		}
+		if d.timeFocus && d.segment == minuteSegment {
+			minuteStyle = styles.CalendarCursor
+		}
+		sb.WriteString("\n\n")
+		sb.WriteString(styles.CalendarWeekday.Render("Time "))
+		sb.WriteString(hourStyle.Render(hour))
+		sb.WriteString(styles.CalendarDay.Render(":"))
+		sb.WriteString(minuteStyle.Render(minute))
+	}
+
+	return styles.Base.
+		Width(d.width).
+		Height(d.height).
+		Render(sb.String())
+}
+
+// renderCalendar renders the month of the cursor, styling each day with
+// dayStyle.
+func renderCalendar(styles *FieldStyles, cursor time.Time, firstWeekday time.Weekday, dayStyle func(time.Time) lipgloss.Style) string {
+	const cellWidth = 2
+	width := daysPerWeek*(cellWidth+1) - 1
+
+	var sb strings.Builder
+	header := cursor.Format("January 2006")
+	sb.WriteString(styles.CalendarHeader.Render(lipgloss.PlaceHorizontal(width, lipgloss.Center, header)))
+	sb.WriteString("\n")
+
+	weekdays := make([]string, daysPerWeek)
+	for i := range weekdays {
+		weekdays[i] = ((firstWeekday + time.Weekday(i)) % daysPerWeek).String()[:cellWidth]
+	}
+	sb.WriteString(styles.CalendarWeekday.Render(strings.Join(weekdays, " ")))
+
+	first := time.Date(cursor.Year(), cursor.Month(), 1, 0, 0, 0, 0, cursor.Location())
+	offset := (int(first.Weekday()) - int(firstWeekday) + daysPerWeek) % daysPerWeek
+	day := first.AddDate(0, 0, -offset)
+	for day.Month() == cursor.Month() || day.Before(first) {
+		sb.WriteString("\n")
+		for i := range daysPerWeek {
+			if i > 0 {
+				sb.WriteString(" ")
+			}
+			if day.Month() != cursor.Month() {
+				sb.WriteString(strings.Repeat(" ", cellWidth))
+			} else {
+				sb.WriteString(dayStyle(day).Render(fmt.Sprintf("%*d", cellWidth, day.Day())))
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return sb.String()
+}
+
+// clampDay returns the day within the days of the bounds, if any.
+func clampDay(t time.Time, minimum, maximum *time.Time) time.Time {
+	if minimum != nil && t.Before(startOfDay(*minimum)) {
+		t = startOfDay(*minimum)
+	}
+	if maximum != nil && t.After(startOfDay(*maximum)) {
+		t = startOfDay(*maximum)
+	}
+	return t
+}
+
+// startOfDay returns midnight of the day of t.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// addMonths adds months to t, keeping the day within the month it lands on.
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), last)-1)
+}
+
+// Run runs the date picker field.
+func (d *DatePicker) Run() error {
+	return Run(d)
+}
+
+// RunAccessible runs the date picker field in accessible mode.
+//
+// The date is typed in the date picker's layout.
+func (d *DatePicker) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := d.activeStyles()
+	layout := d.getLayout()
+	prompt := styles.Title.
+		PaddingRight(1).
+		Render(cmp.Or(d.title.val, "Date"), "("+layout+"):")
+	input := accessibility.PromptString(w, r, prompt, d.format(d.value()), func(s string) error {
+		if strings.TrimSpace(s) == "" {
+			return d.check(d.value())
+		}
+		t, err := d.parse(s)
+		if err != nil {
+			return err
+		}
+		return d.check(t)
+	})
+	t, err := d.parse(input)
+	if err != nil {
+		return err
+	}
+	d.set(t)
+	return nil
+}
+
+// answer sets the value of the date picker from an answers source.
+func (d *DatePicker) answer(value any) error {
+	t, isTime := value.(time.Time)
+	if !isTime {
+		var err error
+		if t, err = d.parse(answerString(value)); err != nil {
+			return fmt.Errorf("%q %w", answerString(value), err)
+		}
+	}
+	d.set(t)
+	return d.check(d.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (d *DatePicker) review() (string, string) {
+	return d.title.val, d.format(d.accessor.Get())
+}
+
+// WithKeyMap sets the keymap on a date picker.
+func (d *DatePicker) WithKeyMap(k *KeyMap) Field {
+	d.keymap = k.DatePicker
+	d.setTimeFocus(d.timeFocus)
+	return d
+}
+
+// WithTheme sets the theme of the date picker.
+func (d *DatePicker) WithTheme(theme Theme) Field {
+	if d.theme != nil {
+		return d
+	}
+	d.theme = theme
+	return d
+}
+
+// WithWidth sets the width of the date picker.
+func (d *DatePicker) WithWidth(width int) Field {
+	d.width = width
+	return d
+}
+
+// WithHeight sets the height of the date picker.
+func (d *DatePicker) WithHeight(height int) Field {
+	d.height = height
+	return d
+}
+
+// WithPosition sets the position of the date picker.
+func (d *DatePicker) WithPosition(p FieldPosition) Field {
+	d.keymap.Prev.SetEnabled(!p.IsFirst())
+	d.keymap.Next.SetEnabled(!p.IsLast())
+	d.keymap.Submit.SetEnabled(p.IsLast())
+	return d
+}
+
+// GetKey returns the key of the field.
+func (d *DatePicker) GetKey() string { return d.key }
+
+// GetValue returns the value of the field.
+func (d *DatePicker) GetValue() any {
+	return d.accessor.Get()
+}
@@ -0,0 +1,663 @@
+package huh
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+// DateRange is a range of days, from Start to End, both included.
+type DateRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// String returns the range as start..end, in the [DateLayout].
+func (r DateRange) String() string {
+	return r.Start.Format(DateLayout) + ".." + r.End.Format(DateLayout)
+}
+
+// DateRangePreset is a quick way to pick a range, such as "Last 7 days".
+type DateRangePreset struct {
+	Name  string
+	Range func(now time.Time) DateRange
+}
+
+// PresetLastDays returns a preset for the last n days, today included.
+func PresetLastDays(n int) DateRangePreset {
+	return DateRangePreset{
+		Name: fmt.Sprintf("Last %d days", n),
+		Range: func(now time.Time) DateRange {
+			today := startOfDay(now)
+			return DateRange{Start: today.AddDate(0, 0, 1-n), End: today}
+		},
+	}
+}
+
+// PresetThisMonth returns a preset for the current month.
+func PresetThisMonth() DateRangePreset {
+	return DateRangePreset{
+		Name: "This month",
+		Range: func(now time.Time) DateRange {
+			start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+			return DateRange{Start: start, End: start.AddDate(0, 1, -1)}
+		},
+	}
+}
+
+// PresetLastMonth returns a preset for the previous month.
+func PresetLastMonth() DateRangePreset {
+	return DateRangePreset{
+		Name: "Last month",
+		Range: func(now time.Time) DateRange {
+			start := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, now.Location())
+			return DateRange{Start: start, End: start.AddDate(0, 1, -1)}
+		},
+	}
+}
+
+// DateRangePicker is a form field to pick a range of days on a month
+// calendar.
+type DateRangePicker struct {
+	accessor Accessor[DateRange]
+	key      string
+	hide     func() bool
+	id       int
+
+	// customization
+	title        Eval[string]
+	description  Eval[string]
+	layout       string
+	firstWeekday time.Weekday
+	presets      []DateRangePreset
+
+	// bounds
+	minimum *time.Time
+	maximum *time.Time
+	maxDays int
+
+	// error handling
+	validate func(DateRange) error
+	err      error
+
+	// state
+	cursor     time.Time
+	pickingEnd bool // whether the start is picked and the end is next
+	focused    bool
+	now        func() time.Time
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    DateRangePickerKeyMap
+}
+
+// NewDateRangePicker returns a new date range picker field.
+//
+// By default it offers the last 7 and 30 days, and this month, as presets.
+func NewDateRangePicker() *DateRangePicker {
+	d := &DateRangePicker{
+		accessor:     &EmbeddedAccessor[DateRange]{},
+		id:           nextID(),
+		title:        Eval[string]{cache: make(map[uint64]string)},
+		description:  Eval[string]{cache: make(map[uint64]string)},
+		firstWeekday: time.Sunday,
+		presets:      []DateRangePreset{PresetLastDays(7), PresetLastDays(30), PresetThisMonth()}, //nolint:mnd
+		validate:     func(DateRange) error { return nil },
+		now:          time.Now,
+	}
+	d.load()
+	return d
+}
+
+// Value sets the value of the date range picker.
+func (d *DateRangePicker) Value(value *DateRange) *DateRangePicker {
+	return d.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the date range picker.
+func (d *DateRangePicker) Accessor(accessor Accessor[DateRange]) *DateRangePicker {
+	d.accessor = accessor
+	d.load()
+	return d
+}
+
+// Key sets the key of the date range picker.
+func (d *DateRangePicker) Key(key string) *DateRangePicker {
+	d.key = key
+	return d
+}
+
+// Title sets the title of the date range picker.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (d *DateRangePicker) Title(title string) *DateRangePicker {
+	d.title.val = title
+	d.title.fn = nil
+	return d
+}
+
+// TitleFunc sets the title func of the date range picker.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (d *DateRangePicker) TitleFunc(f func() string, bindings any) *DateRangePicker {
+	d.title.fn = f
+	d.title.bindings = bindings
+	return d
+}
+
+// Description sets the description of the date range picker.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (d *DateRangePicker) Description(description string) *DateRangePicker {
+	d.description.val = description
+	d.description.fn = nil
+	return d
+}
+
+// DescriptionFunc sets the description func of the date range picker.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (d *DateRangePicker) DescriptionFunc(f func() string, bindings any) *DateRangePicker {
+	d.description.fn = f
+	d.description.bindings = bindings
+	return d
+}
+
+// Min sets the earliest day allowed.
+func (d *DateRangePicker) Min(t time.Time) *DateRangePicker {
+	d.minimum = &t
+	d.cursor = clampDay(d.cursor, d.minimum, d.maximum)
+	return d
+}
+
+// Max sets the latest day allowed.
+func (d *DateRangePicker) Max(t time.Time) *DateRangePicker {
+	d.maximum = &t
+	d.cursor = clampDay(d.cursor, d.minimum, d.maximum)
+	return d
+}
+
+// MaxDays sets the most days the range can cover, both ends included.
+func (d *DateRangePicker) MaxDays(days int) *DateRangePicker {
+	d.maxDays = days
+	return d
+}
+
+// Presets sets the presets offered to pick a range quickly. The first nine
+// presets are picked with the 1 to 9 keys.
+func (d *DateRangePicker) Presets(presets ...DateRangePreset) *DateRangePicker {
+	d.presets = presets
+	return d
+}
+
+// FirstWeekday sets the day weeks start on. Defaults to Sunday.
+func (d *DateRangePicker) FirstWeekday(day time.Weekday) *DateRangePicker {
+	d.firstWeekday = day
+	return d
+}
+
+// Layout sets the layout used to show the days and, in accessible mode, to
+// parse typed days. Defaults to [DateLayout].
+func (d *DateRangePicker) Layout(layout string) *DateRangePicker {
+	d.layout = layout
+	return d
+}
+
+// Validate sets the validation function of the date range picker.
+func (d *DateRangePicker) Validate(validate func(DateRange) error) *DateRangePicker {
+	d.validate = validate
+	return d
+}
+
+// Error returns the error of the date range picker.
+func (d *DateRangePicker) Error() error { return d.err }
+
+// Skip returns whether the date range picker should be skipped or should be
+// blocking.
+func (d *DateRangePicker) Skip() bool { return d.hidden() }
+
+// Hide sets whether the date range picker is hidden.
+func (d *DateRangePicker) Hide(hide bool) *DateRangePicker {
+	return d.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the date range picker is
+// hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (d *DateRangePicker) HideFunc(hideFunc func() bool) *DateRangePicker {
+	d.hide = hideFunc
+	return d
+}
+
+// hidden returns whether the date range picker is hidden.
+func (d *DateRangePicker) hidden() bool { return d.hide != nil && d.hide() }
+
+// Zoom returns whether the date range picker should be zoomed.
+func (*DateRangePicker) Zoom() bool { return false }
+
+// Focus focuses the date range picker.
+func (d *DateRangePicker) Focus() tea.Cmd {
+	d.focused = true
+	return nil
+}
+
+// Blur blurs the date range picker. A range left with only its start picked
+// fails validation, until its end is picked.
+func (d *DateRangePicker) Blur() tea.Cmd {
+	d.focused = false
+	if d.pickingEnd {
+		d.err = errors.New("pick the end of the range")
+		return nil
+	}
+	d.err = d.check(d.accessor.Get())
+	return nil
+}
+
+// KeyBinds returns the help message for the date range picker.
+func (d *DateRangePicker) KeyBinds() []key.Binding {
+	return []key.Binding{
+		d.keymap.Pick, d.keymap.Left, d.keymap.Right, d.keymap.Up, d.keymap.Down,
+		d.keymap.PrevMonth, d.keymap.NextMonth, d.keymap.Preset,
+		d.keymap.Prev, d.keymap.Submit, d.keymap.Next,
+	}
+}
+
+// Init initializes the date range picker.
+func (d *DateRangePicker) Init() tea.Cmd {
+	return nil
+}
+
+// Update updates the date range picker.
+func (d *DateRangePicker) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		d.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		if ok, hash := d.title.shouldUpdate(); ok {
+			d.title.bindingsHash = hash
+			if !d.title.loadFromCache() {
+				d.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: d.id, title: d.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := d.description.shouldUpdate(); ok {
+			d.description.bindingsHash = hash
+			if !d.description.loadFromCache() {
+				d.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: d.id, description: d.description.fn(), hash: hash}
+				})
+			}
+		}
+	case updateTitleMsg:
+		if msg.id == d.id && msg.hash == d.title.bindingsHash {
+			d.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == d.id && msg.hash == d.description.bindingsHash {
+			d.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		d.err = nil
+		switch {
+		case key.Matches(msg, d.keymap.Prev):
+			cmds = append(cmds, PrevField)
+		case key.Matches(msg, d.keymap.Next, d.keymap.Submit):
+			if d.pickingEnd {
+				d.err = errors.New("pick the end of the range")
+				break
+			}
+			if d.err = d.check(d.accessor.Get()); d.err != nil {
+				break
+			}
+			cmds = append(cmds, NextField)
+		case key.Matches(msg, d.keymap.Pick):
+			d.pick()
+		case key.Matches(msg, d.keymap.Preset):
+			i, _ := strconv.Atoi(msg.String())
+			if i >= 1 && i <= len(d.presets) {
+				d.applyPreset(d.presets[i-1])
+			}
+		case key.Matches(msg, d.keymap.Left):
+			d.moveCursor(d.cursor.AddDate(0, 0, -1))
+		case key.Matches(msg, d.keymap.Right):
+			d.moveCursor(d.cursor.AddDate(0, 0, 1))
+		case key.Matches(msg, d.keymap.Up):
+			d.moveCursor(d.cursor.AddDate(0, 0, -daysPerWeek))
+		case key.Matches(msg, d.keymap.Down):
+			d.moveCursor(d.cursor.AddDate(0, 0, daysPerWeek))
+		case key.Matches(msg, d.keymap.PrevMonth):
+			d.moveCursor(addMonths(d.cursor, -1))
+		case key.Matches(msg, d.keymap.NextMonth):
+			d.moveCursor(addMonths(d.cursor, 1))
+		}
+	}
+
+	return d, tea.Batch(cmds...)
+}
+
+// pick picks the day under the cursor as the start of the range or, once the
+// start is picked, as the end. Picking an end before the start starts over.
+func (d *DateRangePicker) pick() {
+	r := d.accessor.Get()
+	if !d.pickingEnd || d.cursor.Before(r.Start) {
+		d.accessor.Set(DateRange{Start: d.cursor, End: d.cursor})
+		d.pickingEnd = true
+		return
+	}
+	r.End = d.cursor
+	d.accessor.Set(r)
+	d.pickingEnd = false
+	d.err = d.check(r)
+}
+
+// applyPreset picks the range of the preset, within the bounds.
+func (d *DateRangePicker) applyPreset(preset DateRangePreset) {
+	r := preset.Range(d.now())
+	r.Start = clampDay(startOfDay(r.Start), d.minimum, d.maximum)
+	r.End = clampDay(startOfDay(r.End), d.minimum, d.maximum)
+	d.accessor.Set(r)
+	d.cursor = r.End
+	d.pickingEnd = false
+	d.err = d.check(r)
+}
+
+// moveCursor moves the cursor to the given day, within the bounds.
+func (d *DateRangePicker) moveCursor(t time.Time) {
+	d.cursor = clampDay(t, d.minimum, d.maximum)
+}
+
+// load sets the cursor from the value, defaulting to today.
+func (d *DateRangePicker) load() {
+	t := d.accessor.Get().End
+	if t.IsZero() {
+		t = d.now()
+	}
+	d.cursor = clampDay(startOfDay(t), d.minimum, d.maximum)
+	d.pickingEnd = false
+}
+
+// check checks the range, its span, the bounds and the validation function.
+func (d *DateRangePicker) check(r DateRange) error {
+	if r.Start.IsZero() || r.End.IsZero() {
+		return errors.New("pick a range")
+	}
+	if r.End.Before(r.Start) {
+		return errors.New("must end after it starts")
+	}
+	if d.minimum != nil && r.Start.Before(startOfDay(*d.minimum)) {
+		return fmt.Errorf("must start on or after %s", d.format(*d.minimum))
+	}
+	if d.maximum != nil && r.End.After(startOfDay(*d.maximum)) {
+		return fmt.Errorf("must end on or before %s", d.format(*d.maximum))
+	}
+	if d.maxDays > 0 && !r.End.Before(r.Start.AddDate(0, 0, d.maxDays)) {
+		return fmt.Errorf("must span at most %d days", d.maxDays)
+	}
+	return d.validate(r)
+}
+
+func (d *DateRangePicker) format(t time.Time) string {
+	return t.Format(cmp.Or(d.layout, DateLayout))
+}
+
+// parse parses a day with the layout, or as RFC 3339.
+func (d *DateRangePicker) parse(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	layout := cmp.Or(d.layout, DateLayout)
+	if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return startOfDay(t), nil
+	}
+	return time.Time{}, fmt.Errorf("must be a date like %s", d.now().Format(layout))
+}
+
+// parseRange parses a range written as start..end.
+func (d *DateRangePicker) parseRange(s string) (DateRange, error) {
+	start, end, ok := strings.Cut(s, "..")
+	if !ok {
+		return DateRange{}, fmt.Errorf("%q must be a range like start..end", s)
+	}
+	var r DateRange
+	var err error
+	if r.Start, err = d.parse(start); err != nil {
+		return r, err
+	}
+	if r.End, err = d.parse(end); err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+func (d *DateRangePicker) activeStyles() *FieldStyles {
+	theme := d.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if d.focused {
+		return &theme.Theme(d.hasDarkBg).Focused
+	}
+	return &theme.Theme(d.hasDarkBg).Blurred
+}
+
+// View renders the date range picker.
+func (d *DateRangePicker) View() string {
+	styles := d.activeStyles()
+	maxWidth := d.width - styles.Base.GetHorizontalFrameSize()
+
+	var sb strings.Builder
+	if d.title.val != "" || d.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(d.title.val, maxWidth)))
+		if d.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if d.description.val != "" || d.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(d.description.val, maxWidth)))
+		sb.WriteString("\n")
+	}
+
+	r := d.accessor.Get()
+	if d.pickingEnd {
+		r.End = d.cursor
+	}
+	today := startOfDay(d.now())
+	sb.WriteString(renderCalendar(styles, d.cursor, d.firstWeekday, func(day time.Time) lipgloss.Style {
+		switch {
+		case day.Equal(d.cursor) && d.focused:
+			return styles.CalendarCursor
+		case day.Equal(r.Start), day.Equal(r.End):
+			return styles.CalendarSelected
+		case day.After(r.Start) && day.Before(r.End):
+			return styles.CalendarRange
+		case !clampDay(day, d.minimum, d.maximum).Equal(day):
+			return styles.CalendarDisabled
+		case day.Equal(today):
+			return styles.CalendarToday
+		default:
+			return styles.CalendarDay
+		}
+	}))
+
+	sb.WriteString("\n\n")
+	switch {
+	case d.pickingEnd:
+		sb.WriteString(styles.CalendarWeekday.Render(d.format(r.Start) + " → ?"))
+	case !r.Start.IsZero():
+		sb.WriteString(styles.CalendarWeekday.Render(d.format(r.Start) + " → " + d.format(r.End)))
+	}
+	for i, preset := range d.presets {
+		if i >= 9 { //nolint:mnd
+			break
+		}
+		sb.WriteString("\n")
+		sb.WriteString(styles.CalendarWeekday.Render(strconv.Itoa(i+1) + " "))
+		sb.WriteString(styles.CalendarDay.Render(preset.Name))
+	}
+
+	return styles.Base.
+		Width(d.width).
+		Height(d.height).
+		Render(sb.String())
+}
+
+// Run runs the date range picker field.
+func (d *DateRangePicker) Run() error {
+	return Run(d)
+}
+
+// RunAccessible runs the date range picker field in accessible mode.
+//
+// The start and the end are typed one after the other, in the layout.
+func (d *DateRangePicker) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := d.activeStyles()
+	layout := cmp.Or(d.layout, DateLayout)
+	if d.title.val != "" {
+		_, _ = fmt.Fprintln(w, styles.Title.Render(d.title.val))
+	}
+
+	value := d.accessor.Get()
+	for {
+		start := d.promptDay(w, r, styles.Title.Render("Start", "("+layout+"):")+" ", value.Start, func(t time.Time) error {
+			return d.check(DateRange{Start: t, End: t})
+		})
+		end := d.promptDay(w, r, styles.Title.Render("End", "("+layout+"):")+" ", value.End, nil)
+		value = DateRange{Start: start, End: end}
+		err := d.check(value)
+		if err == nil {
+			break
+		}
+		_, _ = fmt.Fprintln(w, err)
+	}
+	d.accessor.Set(value)
+	d.load()
+	return nil
+}
+
+// promptDay prompts for a day until a valid one is typed.
+func (d *DateRangePicker) promptDay(w io.Writer, r io.Reader, prompt string, defaultValue time.Time, validate func(time.Time) error) time.Time {
+	var def string
+	if !defaultValue.IsZero() {
+		def = d.format(defaultValue)
+	}
+	input := accessibility.PromptString(w, r, prompt, def, func(s string) error {
+		if strings.TrimSpace(s) == "" && def != "" {
+			return nil
+		}
+		t, err := d.parse(s)
+		if err != nil || validate == nil {
+			return err
+		}
+		return validate(t)
+	})
+	t, _ := d.parse(input)
+	return t
+}
+
+// answer sets the value of the date range picker from an answers source.
+//
+// Ranges are written as start..end, in the layout.
+func (d *DateRangePicker) answer(value any) error {
+	var r DateRange
+	var err error
+	switch v := value.(type) {
+	case DateRange:
+		r = v
+	case map[string]any:
+		// as saved in drafts.
+		if r.Start, err = d.parse(answerString(v["start"])); err != nil {
+			return err
+		}
+		if r.End, err = d.parse(answerString(v["end"])); err != nil {
+			return err
+		}
+	default:
+		if r, err = d.parseRange(answerString(value)); err != nil {
+			return err
+		}
+	}
+	d.accessor.Set(r)
+	d.load()
+	return d.check(d.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (d *DateRangePicker) review() (string, string) {
+	r := d.accessor.Get()
+	if r.Start.IsZero() {
+		return d.title.val, ""
+	}
+	return d.title.val, d.format(r.Start) + " → " + d.format(r.End)
+}
+
+// WithKeyMap sets the keymap on a date range picker.
+func (d *DateRangePicker) WithKeyMap(k *KeyMap) Field {
+	d.keymap = k.DateRangePicker
+	return d
+}
+
+// WithTheme sets the theme of the date range picker.
+func (d *DateRangePicker) WithTheme(theme Theme) Field {
+	if d.theme != nil {
+		return d
+	}
+	d.theme = theme
+	return d
+}
+
+// WithWidth sets the width of the date range picker.
+func (d *DateRangePicker) WithWidth(width int) Field {
+	d.width = width
+	return d
+}
+
+// WithHeight sets the height of the date range picker.
+func (d *DateRangePicker) WithHeight(height int) Field {
+	d.height = height
+	return d
+}
+
+// WithPosition sets the position of the date range picker.
+func (d *DateRangePicker) WithPosition(p FieldPosition) Field {
+	d.keymap.Prev.SetEnabled(!p.IsFirst())
+	d.keymap.Next.SetEnabled(!p.IsLast())
+	d.keymap.Submit.SetEnabled(p.IsLast())
+	return d
+}
+
+// GetKey returns the key of the field.
+func (d *DateRangePicker) GetKey() string { return d.key }
+
+// GetValue returns the value of the field.
+func (d *DateRangePicker) GetValue() any {
+	return d.accessor.Get()
+}
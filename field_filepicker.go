@@ -20,6 +20,7 @@ import (
 type FilePicker struct {
 	accessor Accessor[string]
 	key      string
+	hide     func() bool
 	picker   filepicker.Model
 
 	// state
@@ -165,10 +166,25 @@ func (f *FilePicker) Error() error {
 }
 
 // Skip returns whether the file should be skipped or should be blocking.
-func (*FilePicker) Skip() bool {
-	return false
+func (f *FilePicker) Skip() bool { return f.hidden() }
+
+// Hide sets whether the file picker is hidden.
+func (f *FilePicker) Hide(hide bool) *FilePicker {
+	return f.HideFunc(func() bool { return hide })
 }
 
+// HideFunc sets the function that checks whether the file picker is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (f *FilePicker) HideFunc(hideFunc func() bool) *FilePicker {
+	f.hide = hideFunc
+	return f
+}
+
+// hidden returns whether the file picker is hidden.
+func (f *FilePicker) hidden() bool { return f.hide != nil && f.hide() }
+
 // Zoom returns whether the input should be zoomed.
 func (f *FilePicker) Zoom() bool {
 	return f.picking
@@ -353,6 +369,17 @@ func (f *FilePicker) RunAccessible(w io.Writer, r io.Reader) error {
 	return nil
 }
 
+// answer sets the value of the file field from an answers source.
+func (f *FilePicker) answer(value any) error {
+	f.accessor.Set(answerString(value))
+	return f.validate(f.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (f *FilePicker) review() (string, string) {
+	return f.title, f.accessor.Get()
+}
+
 // copied from bubbles' filepicker.
 const (
 	fileSizeWidth = 7
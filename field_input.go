@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/textinput"
@@ -23,6 +25,7 @@ import (
 type Input struct {
 	accessor Accessor[string]
 	key      string
+	hide     func() bool
 	id       int
 
 	title       Eval[string]
@@ -37,6 +40,16 @@ type Input struct {
 	err      error
 	focused  bool
 
+	mask       *inputMask
+	maskRaw    bool
+	maskValue  []rune // runes filling the slots of the mask
+	maskCursor int    // slot under the cursor
+
+	secret         Accessor[[]byte]
+	secretValue    secretBuffer
+	secretLoaded   bool // whether secretValue holds the secret being typed
+	validateSecret func([]byte) error
+
 	width  int
 	height int
 
@@ -76,7 +89,32 @@ func (i *Input) Value(value *string) *Input {
 // Accessor sets the accessor of the input field.
 func (i *Input) Accessor(accessor Accessor[string]) *Input {
 	i.accessor = accessor
-	i.textinput.SetValue(i.accessor.Get())
+	i.setValue(i.accessor.Get())
+	return i
+}
+
+// SecretValue sets the value of the input field to a secret, held in bytes
+// rather than a string so it can be wiped with Zero once it's been used.
+func (i *Input) SecretValue(value *[]byte) *Input {
+	return i.SecretAccessor(NewPointerAccessor(value))
+}
+
+// SecretAccessor sets the accessor of the secret value of the input field.
+//
+// The secret being typed is kept in a buffer of the input, wiped when the
+// input is blurred or submitted, then set as a new slice on the accessor. The
+// slice it replaces is zeroed. The value of Value or Accessor is left empty,
+// and Validate doesn't apply: use ValidateSecret instead.
+func (i *Input) SecretAccessor(accessor Accessor[[]byte]) *Input {
+	i.secret = accessor
+	i.setSecretText(utf8.RuneCount(i.secret.Get()), 0)
+	return i
+}
+
+// ValidateSecret sets the validation function of the secret value of the
+// input field.
+func (i *Input) ValidateSecret(validate func([]byte) error) *Input {
+	i.validateSecret = validate
 	return i
 }
 
@@ -208,6 +246,32 @@ func (i *Input) Password(password bool) *Input {
 	return i
 }
 
+// Mask sets a pattern the input must match, such as "+1 (999) 999-9999".
+//
+// In a pattern, 9 is a digit, A is a letter, * is a letter or a digit, and any
+// other rune is a literal; a backslash makes the next rune a literal. Literals
+// are shown while typing, only matching runes are accepted at each position,
+// and the cursor moves past literals. An empty pattern removes the mask.
+func (i *Input) Mask(pattern string) *Input {
+	i.mask = nil
+	if pattern != "" {
+		i.mask = parseMask(pattern)
+	}
+	i.setValue(i.accessor.Get())
+	return i
+}
+
+// MaskRaw sets whether the value of a masked input only holds the runes typed
+// in the slots of the mask, without its literals. Defaults to false, the value
+// as formatted by the mask.
+func (i *Input) MaskRaw(raw bool) *Input {
+	i.maskRaw = raw
+	if i.mask != nil {
+		i.accessor.Set(i.value())
+	}
+	return i
+}
+
 // Placeholder sets the placeholder of the text input.
 func (i *Input) Placeholder(str string) *Input {
 	i.textinput.Placeholder = str
@@ -237,7 +301,24 @@ func (i *Input) Validate(validate func(string) error) *Input {
 func (i *Input) Error() error { return i.err }
 
 // Skip returns whether the input should be skipped or should be blocking.
-func (*Input) Skip() bool { return false }
+func (i *Input) Skip() bool { return i.hidden() }
+
+// Hide sets whether the input is hidden.
+func (i *Input) Hide(hide bool) *Input {
+	return i.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the input is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (i *Input) HideFunc(hideFunc func() bool) *Input {
+	i.hide = hideFunc
+	return i
+}
+
+// hidden returns whether the input is hidden.
+func (i *Input) hidden() bool { return i.hide != nil && i.hide() }
 
 // Zoom returns whether the input should be zoomed.
 func (*Input) Zoom() bool { return false }
@@ -245,15 +326,29 @@ func (*Input) Zoom() bool { return false }
 // Focus focuses the input field.
 func (i *Input) Focus() tea.Cmd {
 	i.focused = true
+	if i.secret != nil {
+		i.secretValue.load(i.secret.Get())
+		i.secretLoaded = true
+		i.setSecretText(len(i.secretValue.runes), i.secretValue.cursor)
+	}
 	return i.textinput.Focus()
 }
 
 // Blur blurs the input field.
 func (i *Input) Blur() tea.Cmd {
+	if i.secret != nil {
+		if i.secretLoaded {
+			i.storeSecret()
+		}
+		i.focused = false
+		i.textinput.Blur()
+		i.err = i.checkSecret(i.secret.Get())
+		return nil
+	}
 	i.focused = false
-	i.accessor.Set(i.textinput.Value())
+	i.accessor.Set(i.value())
 	i.textinput.Blur()
-	i.err = i.validate(i.accessor.Get())
+	i.err = i.check(i.accessor.Get())
 	return nil
 }
 
@@ -348,23 +443,190 @@ func (i *Input) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case key.Matches(msg, i.keymap.Prev):
 			cmds = append(cmds, PrevField)
 		case key.Matches(msg, i.keymap.Next, i.keymap.Submit):
-			value := i.textinput.Value()
-			i.err = i.validate(value)
+			if i.secret != nil {
+				secret := i.secretValue.bytes()
+				i.err = i.checkSecret(secret)
+				Zero(secret)
+			} else {
+				i.err = i.check(i.value())
+			}
 			if i.err != nil {
 				return i, nil
 			}
+			// the form saves the field's value on NextField, so the secret
+			// is stored before.
+			if i.secret != nil {
+				i.storeSecret()
+				return i, NextField
+			}
 			cmds = append(cmds, NextField)
 		}
+
+		if i.secret != nil {
+			i.secretValue.update(i.textinput.KeyMap, msg, i.textinput.CharLimit)
+			i.setSecretText(len(i.secretValue.runes), i.secretValue.cursor)
+			return i, tea.Batch(cmds...)
+		}
+
+		if i.mask != nil {
+			i.updateMask(msg)
+			i.accessor.Set(i.value())
+			return i, tea.Batch(cmds...)
+		}
+	case tea.PasteMsg:
+		// pasted secrets go to the buffer, never to the text input.
+		if i.secret != nil {
+			i.err = nil
+			i.secretValue.insert(msg.Content, i.textinput.CharLimit)
+			i.setSecretText(len(i.secretValue.runes), i.secretValue.cursor)
+			return i, nil
+		}
+		// pasted text goes through the mask, as if it was typed.
+		if i.mask != nil {
+			i.err = nil
+			i.insertMask(msg.Content)
+			i.updateMaskText()
+			i.accessor.Set(i.value())
+			return i, nil
+		}
 	}
 
 	var cmd tea.Cmd
 	i.textinput, cmd = i.textinput.Update(msg)
 	cmds = append(cmds, cmd)
-	i.accessor.Set(i.textinput.Value())
+	if i.secret == nil {
+		i.accessor.Set(i.value())
+	}
 
 	return i, tea.Batch(cmds...)
 }
 
+// updateMask edits the runes of a masked input. The runes fill the slots of the
+// mask from the start, so the cursor stays within them.
+func (i *Input) updateMask(msg tea.KeyPressMsg) {
+	keymap := i.textinput.KeyMap
+	switch {
+	case key.Matches(msg, keymap.DeleteCharacterBackward):
+		if i.maskCursor > 0 {
+			i.maskCursor--
+			i.maskValue = slices.Delete(i.maskValue, i.maskCursor, i.maskCursor+1)
+		}
+	case key.Matches(msg, keymap.DeleteCharacterForward):
+		if i.maskCursor < len(i.maskValue) {
+			i.maskValue = slices.Delete(i.maskValue, i.maskCursor, i.maskCursor+1)
+		}
+	case key.Matches(msg, keymap.DeleteBeforeCursor):
+		i.maskValue = i.maskValue[i.maskCursor:]
+		i.maskCursor = 0
+	case key.Matches(msg, keymap.DeleteAfterCursor):
+		i.maskValue = i.maskValue[:i.maskCursor]
+	case key.Matches(msg, keymap.CharacterBackward):
+		i.maskCursor = max(i.maskCursor-1, 0)
+	case key.Matches(msg, keymap.CharacterForward):
+		i.maskCursor = min(i.maskCursor+1, len(i.maskValue))
+	case key.Matches(msg, keymap.LineStart):
+		i.maskCursor = 0
+	case key.Matches(msg, keymap.LineEnd):
+		i.maskCursor = len(i.maskValue)
+	default:
+		i.insertMask(msg.Text)
+	}
+
+	// Runes moved to other slots by a deletion may not fit them anymore.
+	i.maskValue = i.mask.valid(i.maskValue)
+	i.maskCursor = min(i.maskCursor, len(i.maskValue))
+	i.updateMaskText()
+}
+
+// insertMask types text into a masked input at the cursor, dropping the runes
+// that don't fit their slot.
+func (i *Input) insertMask(text string) {
+	for _, r := range text {
+		if !i.mask.accepts(i.maskCursor, r) {
+			continue
+		}
+		if i.maskCursor < len(i.maskValue) {
+			i.maskValue[i.maskCursor] = r
+		} else {
+			i.maskValue = append(i.maskValue, r)
+		}
+		i.maskCursor++
+	}
+}
+
+// updateMaskText shows the mask filled with its runes, with the cursor on its
+// slot.
+func (i *Input) updateMaskText() {
+	i.textinput.SetValue(i.mask.fill(i.maskValue))
+	i.textinput.SetCursor(i.mask.position(i.maskCursor))
+}
+
+// setSecretText fills the text input with as many echo characters as the
+// secret has runes, so the secret itself never reaches it.
+func (i *Input) setSecretText(n, cursor int) {
+	i.textinput.SetValue(strings.Repeat(string(i.textinput.EchoCharacter), n))
+	i.textinput.SetCursor(cursor)
+}
+
+// storeSecret sets the secret being typed on the accessor, zeroing the one it
+// replaces, and wipes the buffer.
+func (i *Input) storeSecret() {
+	old := i.secret.Get()
+	i.secret.Set(i.secretValue.bytes())
+	Zero(old)
+	i.secretValue.wipe()
+	i.secretLoaded = false
+}
+
+// checkSecret checks a secret with the secret validation function.
+func (i *Input) checkSecret(secret []byte) error {
+	if i.validateSecret == nil {
+		return nil
+	}
+	return i.validateSecret(secret)
+}
+
+// setValue sets the text of the input, fitting it to the mask if there's one.
+func (i *Input) setValue(value string) {
+	if i.mask == nil {
+		i.textinput.SetValue(value)
+		return
+	}
+	i.maskValue = i.mask.extract(value)
+	i.maskCursor = len(i.maskValue)
+	i.updateMaskText()
+}
+
+// value returns the value of the input: its text, or the runes of the mask
+// formatted as set with MaskRaw.
+func (i *Input) value() string {
+	switch {
+	case i.mask == nil:
+		return i.textinput.Value()
+	case i.maskRaw:
+		return string(i.maskValue)
+	default:
+		return i.mask.format(i.maskValue)
+	}
+}
+
+// checkMask checks that a value fills every slot of the mask, if there's one.
+// Empty values are left to the validation function.
+func (i *Input) checkMask(value string) error {
+	if i.mask != nil && strings.TrimSpace(value) != "" && !i.mask.complete(i.mask.extract(value)) {
+		return fmt.Errorf("must match %s", i.mask)
+	}
+	return nil
+}
+
+// check checks the mask, then the validation function.
+func (i *Input) check(value string) error {
+	if err := i.checkMask(value); err != nil {
+		return err
+	}
+	return i.validate(value)
+}
+
 func (i *Input) activeStyles() *FieldStyles {
 	theme := i.theme
 	if theme == nil {
@@ -431,19 +693,34 @@ func (i *Input) run() error {
 func (i *Input) RunAccessible(w io.Writer, r io.Reader) error {
 	styles := i.activeStyles()
 	validator := func(input string) error {
-		if i.textinput.CharLimit > 0 && len(input) > i.textinput.CharLimit {
+		if i.textinput.CharLimit > 0 && utf8.RuneCountInString(input) > i.textinput.CharLimit {
 			return fmt.Errorf("Input cannot exceed %d characters", i.textinput.CharLimit)
 		}
+		if i.mask != nil {
+			if err := i.checkMask(input); err != nil {
+				return err
+			}
+			i.setValue(input)
+			input = i.value()
+		}
 		return i.validate(input)
 	}
 
+	if i.secret != nil {
+		return i.runSecretAccessible(w, r)
+	}
+
 	switch i.textinput.EchoMode {
 	case textinput.EchoNormal:
 		prompt := styles.Title.
 			PaddingRight(1).
 			Render(cmp.Or(i.title.val, "Input:"))
+		if i.mask != nil {
+			prompt += styles.Description.Render("("+i.mask.String()+")") + " "
+		}
 		value := accessibility.PromptString(w, r, prompt, i.GetValue().(string), validator)
-		i.accessor.Set(value)
+		i.setValue(value)
+		i.accessor.Set(i.value())
 		return nil
 	default:
 		prompt := styles.Title.
@@ -454,13 +731,81 @@ func (i *Input) RunAccessible(w io.Writer, r io.Reader) error {
 			if err != nil {
 				return err //nolint:wrapcheck
 			}
-			i.accessor.Set(value)
+			i.setValue(value)
+			i.accessor.Set(i.value())
 			return nil
 		}
 		return errors.New("password asking needs a tty")
 	}
 }
 
+// runSecretAccessible asks for the secret value of the input field without
+// echo, and without going through a string.
+func (i *Input) runSecretAccessible(w io.Writer, r io.Reader) error {
+	fd, ok := r.(interface{ Fd() uintptr })
+	if !ok {
+		return errors.New("password asking needs a tty")
+	}
+	prompt := i.activeStyles().Title.
+		PaddingRight(1).
+		Render(cmp.Or(i.title.val, "Password:"))
+	secret, err := accessibility.PromptSecret(w, fd.Fd(), prompt, func(input []byte) error {
+		if i.textinput.CharLimit > 0 && utf8.RuneCount(input) > i.textinput.CharLimit {
+			return fmt.Errorf("Input cannot exceed %d characters", i.textinput.CharLimit)
+		}
+		return i.checkSecret(input)
+	})
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	old := i.secret.Get()
+	i.secret.Set(secret)
+	Zero(old)
+	return nil
+}
+
+// answer sets the value of the input field from an answers source.
+func (i *Input) answer(value any) error {
+	if i.secret != nil {
+		old := i.secret.Get()
+		i.secret.Set([]byte(answerString(value)))
+		Zero(old)
+		return i.checkSecret(i.secret.Get())
+	}
+	input := answerString(value)
+	// the text input would cut the answer short.
+	if i.textinput.CharLimit > 0 && utf8.RuneCountInString(input) > i.textinput.CharLimit {
+		return fmt.Errorf("input cannot exceed %d characters", i.textinput.CharLimit)
+	}
+	if err := i.checkMask(input); err != nil {
+		return err
+	}
+	i.setValue(input)
+	i.accessor.Set(i.value())
+	return i.check(i.accessor.Get())
+}
+
+// sensitive returns whether the input holds a password or a secret.
+func (i *Input) sensitive() bool {
+	return i.secret != nil || i.textinput.EchoMode != textinput.EchoNormal
+}
+
+// review returns the title and value shown on the form's review page.
+// Passwords are masked.
+func (i *Input) review() (string, string) {
+	value := i.accessor.Get()
+	if i.secret != nil {
+		value = strings.Repeat(string(i.textinput.EchoCharacter), utf8.RuneCount(i.secret.Get()))
+	}
+	switch i.textinput.EchoMode {
+	case textinput.EchoPassword:
+		value = strings.Repeat(string(i.textinput.EchoCharacter), utf8.RuneCountInString(value))
+	case textinput.EchoNone:
+		value = ""
+	}
+	return i.title.val, value
+}
+
 // WithKeyMap sets the keymap on an input field.
 func (i *Input) WithKeyMap(k *KeyMap) Field {
 	i.keymap = k.Input
@@ -510,6 +855,11 @@ func (i *Input) WithPosition(p FieldPosition) Field {
 func (i *Input) GetKey() string { return i.key }
 
 // GetValue returns the value of the field.
+//
+// The value of an input with a secret value is its secret.
 func (i *Input) GetValue() any {
+	if i.secret != nil {
+		return i.secret.Get()
+	}
 	return i.accessor.Get()
 }
@@ -0,0 +1,668 @@
+package huh
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+// key/value columns.
+const (
+	keyColumn = iota
+	valueColumn
+)
+
+// keyValueRow is a row of a key/value field.
+type keyValueRow struct {
+	key   string
+	value string
+}
+
+// KeyValue is a form field to edit KEY=VALUE pairs, such as environment
+// variables or labels, in a two column table.
+//
+// Rows are shown in the order they were added. Values loaded from the
+// accessor are sorted by key.
+type KeyValue struct {
+	accessor Accessor[map[string]string]
+	key      string
+	hide     func() bool
+	id       int
+
+	// customization
+	title       Eval[string]
+	description Eval[string]
+	keyTitle    string
+	valueTitle  string
+	echoMode    EchoMode
+
+	// error handling
+	validate      func(map[string]string) error
+	validateKey   func(string) error
+	validateValue func(string) error
+	err           error
+
+	// state
+	rows      []keyValueRow
+	row       int
+	column    int
+	textinput textinput.Model
+	focused   bool
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    KeyValueKeyMap
+}
+
+// NewKeyValue returns a new key/value field.
+func NewKeyValue() *KeyValue {
+	input := textinput.New()
+	input.Prompt = ""
+
+	kv := &KeyValue{
+		accessor:      &EmbeddedAccessor[map[string]string]{},
+		id:            nextID(),
+		title:         Eval[string]{cache: make(map[uint64]string)},
+		description:   Eval[string]{cache: make(map[uint64]string)},
+		keyTitle:      "Key",
+		valueTitle:    "Value",
+		validate:      func(map[string]string) error { return nil },
+		validateKey:   func(string) error { return nil },
+		validateValue: func(string) error { return nil },
+		textinput:     input,
+	}
+	kv.load()
+	return kv
+}
+
+// Value sets the value of the key/value field.
+func (kv *KeyValue) Value(value *map[string]string) *KeyValue {
+	return kv.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the key/value field.
+func (kv *KeyValue) Accessor(accessor Accessor[map[string]string]) *KeyValue {
+	kv.accessor = accessor
+	kv.load()
+	return kv
+}
+
+// Key sets the key of the key/value field.
+func (kv *KeyValue) Key(key string) *KeyValue {
+	kv.key = key
+	return kv
+}
+
+// Title sets the title of the key/value field.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (kv *KeyValue) Title(title string) *KeyValue {
+	kv.title.val = title
+	kv.title.fn = nil
+	return kv
+}
+
+// TitleFunc sets the title func of the key/value field.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (kv *KeyValue) TitleFunc(f func() string, bindings any) *KeyValue {
+	kv.title.fn = f
+	kv.title.bindings = bindings
+	return kv
+}
+
+// Description sets the description of the key/value field.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (kv *KeyValue) Description(description string) *KeyValue {
+	kv.description.val = description
+	kv.description.fn = nil
+	return kv
+}
+
+// DescriptionFunc sets the description func of the key/value field.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (kv *KeyValue) DescriptionFunc(f func() string, bindings any) *KeyValue {
+	kv.description.fn = f
+	kv.description.bindings = bindings
+	return kv
+}
+
+// Columns sets the titles of the key and the value columns. Defaults to "Key"
+// and "Value".
+func (kv *KeyValue) Columns(key, value string) *KeyValue {
+	kv.keyTitle, kv.valueTitle = key, value
+	return kv
+}
+
+// EchoMode sets how values are shown, as with [Input.EchoMode]. Keys are
+// always shown.
+func (kv *KeyValue) EchoMode(mode EchoMode) *KeyValue {
+	kv.echoMode = mode
+	return kv
+}
+
+// Validate sets the validation function of all the pairs.
+func (kv *KeyValue) Validate(validate func(map[string]string) error) *KeyValue {
+	kv.validate = validate
+	return kv
+}
+
+// ValidateKey sets the validation function of each key.
+func (kv *KeyValue) ValidateKey(validate func(string) error) *KeyValue {
+	kv.validateKey = validate
+	return kv
+}
+
+// ValidateValue sets the validation function of each value.
+func (kv *KeyValue) ValidateValue(validate func(string) error) *KeyValue {
+	kv.validateValue = validate
+	return kv
+}
+
+// Error returns the error of the key/value field.
+func (kv *KeyValue) Error() error { return kv.err }
+
+// Skip returns whether the key/value field should be skipped or should be
+// blocking.
+func (kv *KeyValue) Skip() bool { return kv.hidden() }
+
+// Hide sets whether the key/value field is hidden.
+func (kv *KeyValue) Hide(hide bool) *KeyValue {
+	return kv.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the key/value field is
+// hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (kv *KeyValue) HideFunc(hideFunc func() bool) *KeyValue {
+	kv.hide = hideFunc
+	return kv
+}
+
+// hidden returns whether the key/value field is hidden.
+func (kv *KeyValue) hidden() bool { return kv.hide != nil && kv.hide() }
+
+// Zoom returns whether the key/value field should be zoomed.
+func (*KeyValue) Zoom() bool { return false }
+
+// Focus focuses the key/value field.
+func (kv *KeyValue) Focus() tea.Cmd {
+	kv.focused = true
+	kv.editCell(kv.row, kv.column)
+	return kv.textinput.Focus()
+}
+
+// Blur blurs the key/value field.
+func (kv *KeyValue) Blur() tea.Cmd {
+	kv.saveCell()
+	kv.focused = false
+	kv.textinput.Blur()
+	kv.err = kv.check()
+	return nil
+}
+
+// KeyBinds returns the help message for the key/value field.
+func (kv *KeyValue) KeyBinds() []key.Binding {
+	return []key.Binding{
+		kv.keymap.NextCell, kv.keymap.PrevCell, kv.keymap.Up, kv.keymap.Down,
+		kv.keymap.AddRow, kv.keymap.DeleteRow, kv.keymap.Submit, kv.keymap.Next,
+	}
+}
+
+// Init initializes the key/value field.
+func (kv *KeyValue) Init() tea.Cmd {
+	kv.textinput.Blur()
+	return nil
+}
+
+// Update updates the key/value field.
+func (kv *KeyValue) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		kv.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		if ok, hash := kv.title.shouldUpdate(); ok {
+			kv.title.bindingsHash = hash
+			if !kv.title.loadFromCache() {
+				kv.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: kv.id, title: kv.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := kv.description.shouldUpdate(); ok {
+			kv.description.bindingsHash = hash
+			if !kv.description.loadFromCache() {
+				kv.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: kv.id, description: kv.description.fn(), hash: hash}
+				})
+			}
+		}
+		return kv, tea.Batch(cmds...)
+	case updateTitleMsg:
+		if msg.id == kv.id && msg.hash == kv.title.bindingsHash {
+			kv.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == kv.id && msg.hash == kv.description.bindingsHash {
+			kv.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		kv.err = nil
+		switch {
+		case key.Matches(msg, kv.keymap.NextCell):
+			if kv.column == keyColumn {
+				kv.moveCell(kv.row, valueColumn)
+			} else if kv.row < len(kv.rows)-1 {
+				kv.moveCell(kv.row+1, keyColumn)
+			} else if kv.keymap.Next.Enabled() {
+				return kv, kv.next()
+			}
+			return kv, nil
+		case key.Matches(msg, kv.keymap.PrevCell):
+			if kv.column == valueColumn {
+				kv.moveCell(kv.row, keyColumn)
+			} else if kv.row > 0 {
+				kv.moveCell(kv.row-1, valueColumn)
+			} else if kv.keymap.Prev.Enabled() {
+				kv.saveCell()
+				return kv, PrevField
+			}
+			return kv, nil
+		case key.Matches(msg, kv.keymap.Prev):
+			kv.saveCell()
+			return kv, PrevField
+		case key.Matches(msg, kv.keymap.Next, kv.keymap.Submit):
+			return kv, kv.next()
+		case key.Matches(msg, kv.keymap.Up):
+			kv.moveCell(kv.row-1, kv.column)
+			return kv, nil
+		case key.Matches(msg, kv.keymap.Down):
+			kv.moveCell(kv.row+1, kv.column)
+			return kv, nil
+		case key.Matches(msg, kv.keymap.AddRow):
+			kv.saveCell()
+			kv.rows = slices.Insert(kv.rows, kv.row+1, keyValueRow{})
+			kv.editCell(kv.row+1, keyColumn)
+			return kv, nil
+		case key.Matches(msg, kv.keymap.DeleteRow):
+			kv.rows = slices.Delete(kv.rows, kv.row, kv.row+1)
+			if len(kv.rows) == 0 {
+				kv.rows = []keyValueRow{{}}
+			}
+			kv.editCell(min(kv.row, len(kv.rows)-1), kv.column)
+			kv.store()
+			return kv, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	kv.textinput, cmd = kv.textinput.Update(msg)
+	cmds = append(cmds, cmd)
+	kv.saveCell()
+	return kv, tea.Batch(cmds...)
+}
+
+// next checks the pairs and moves on to the next field.
+func (kv *KeyValue) next() tea.Cmd {
+	kv.saveCell()
+	if kv.err = kv.check(); kv.err != nil {
+		return nil
+	}
+	return NextField
+}
+
+// moveCell checks the cell being edited and, if it's valid, moves to the
+// given cell.
+func (kv *KeyValue) moveCell(row, column int) {
+	kv.saveCell()
+	if kv.err = kv.checkCell(kv.row, kv.column); kv.err != nil {
+		return
+	}
+	kv.editCell(min(max(row, 0), len(kv.rows)-1), column)
+}
+
+// editCell loads the given cell into the input.
+func (kv *KeyValue) editCell(row, column int) {
+	kv.row, kv.column = row, column
+	cell := kv.rows[row].key
+	kv.textinput.EchoMode = textinput.EchoNormal
+	if column == valueColumn {
+		cell = kv.rows[row].value
+		kv.textinput.EchoMode = textinput.EchoMode(kv.echoMode)
+	}
+	kv.textinput.SetValue(cell)
+	kv.textinput.CursorEnd()
+}
+
+// saveCell saves the input into the cell being edited.
+func (kv *KeyValue) saveCell() {
+	if !kv.focused {
+		return
+	}
+	if kv.column == keyColumn {
+		kv.rows[kv.row].key = strings.TrimSpace(kv.textinput.Value())
+	} else {
+		kv.rows[kv.row].value = kv.textinput.Value()
+	}
+	kv.store()
+}
+
+// store sets the value from the rows, leaving out blank rows.
+func (kv *KeyValue) store() {
+	pairs := make(map[string]string, len(kv.rows))
+	for _, row := range kv.rows {
+		if row.key != "" || row.value != "" {
+			pairs[row.key] = row.value
+		}
+	}
+	kv.accessor.Set(pairs)
+}
+
+// load sets the rows from the value, sorted by key.
+func (kv *KeyValue) load() {
+	pairs := kv.accessor.Get()
+	kv.rows = kv.rows[:0]
+	for _, k := range slices.Sorted(maps.Keys(pairs)) {
+		kv.rows = append(kv.rows, keyValueRow{key: k, value: pairs[k]})
+	}
+	if len(kv.rows) == 0 {
+		kv.rows = append(kv.rows, keyValueRow{})
+	}
+	kv.row, kv.column = 0, keyColumn
+}
+
+// duplicate returns whether the key of the row is used by an earlier row.
+func (kv *KeyValue) duplicate(row int) bool {
+	k := kv.rows[row].key
+	return k != "" && slices.ContainsFunc(kv.rows[:row], func(r keyValueRow) bool { return r.key == k })
+}
+
+// checkCell checks a cell with its validation function.
+func (kv *KeyValue) checkCell(row, column int) error {
+	r := kv.rows[row]
+	if r.key == "" && r.value == "" {
+		return nil
+	}
+	if column == valueColumn {
+		return kv.validateValue(r.value)
+	}
+	if r.key == "" {
+		return errors.New("key can't be empty")
+	}
+	if kv.duplicate(row) {
+		return fmt.Errorf("duplicate key %q", r.key)
+	}
+	return kv.validateKey(r.key)
+}
+
+// check checks every row, then the validation function.
+func (kv *KeyValue) check() error {
+	for i := range kv.rows {
+		for _, column := range []int{keyColumn, valueColumn} {
+			if err := kv.checkCell(i, column); err != nil {
+				return err
+			}
+		}
+	}
+	return kv.validate(kv.accessor.Get())
+}
+
+// mask returns the value as shown with the echo mode.
+func (kv *KeyValue) mask(value string) string {
+	switch textinput.EchoMode(kv.echoMode) {
+	case textinput.EchoPassword:
+		return strings.Repeat(string(kv.textinput.EchoCharacter), utf8.RuneCountInString(value))
+	case textinput.EchoNone:
+		return ""
+	default:
+		return value
+	}
+}
+
+func (kv *KeyValue) activeStyles() *FieldStyles {
+	theme := kv.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if kv.focused {
+		return &theme.Theme(kv.hasDarkBg).Focused
+	}
+	return &theme.Theme(kv.hasDarkBg).Blurred
+}
+
+// View renders the key/value field.
+func (kv *KeyValue) View() string {
+	styles := kv.activeStyles()
+	maxWidth := kv.width - styles.Base.GetHorizontalFrameSize()
+
+	st := kv.textinput.Styles()
+	st.Cursor.Color = styles.TextInput.Cursor.GetForeground()
+	st.Focused.Text = styles.TextInput.Text
+	st.Focused.Placeholder = styles.TextInput.Placeholder
+	kv.textinput.SetStyles(st)
+
+	var sb strings.Builder
+	if kv.title.val != "" || kv.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(kv.title.val, maxWidth)))
+		if kv.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if kv.description.val != "" || kv.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(kv.description.val, maxWidth)))
+		sb.WriteString("\n")
+	}
+
+	keyWidth := lipgloss.Width(kv.keyTitle)
+	for _, row := range kv.rows {
+		keyWidth = max(keyWidth, lipgloss.Width(row.key))
+	}
+	keyWidth++
+	kv.textinput.SetWidth(keyWidth)
+	if kv.column == valueColumn {
+		kv.textinput.SetWidth(max(maxWidth-keyWidth-lipgloss.Width(styles.SelectSelector.String())-1, 1))
+	}
+
+	selector := styles.SelectSelector.String()
+	indent := strings.Repeat(" ", lipgloss.Width(selector))
+	header := kv.keyTitle + strings.Repeat(" ", keyWidth+1-lipgloss.Width(kv.keyTitle)) + kv.valueTitle
+	sb.WriteString(indent + styles.Description.Render(header))
+
+	for i, row := range kv.rows {
+		sb.WriteString("\n")
+		current := kv.focused && i == kv.row
+		if current {
+			sb.WriteString(selector)
+		} else {
+			sb.WriteString(indent)
+		}
+
+		keyCell := styles.Option.Render(row.key)
+		if current && kv.column == keyColumn {
+			keyCell = kv.textinput.View()
+		}
+		sb.WriteString(keyCell)
+		sb.WriteString(strings.Repeat(" ", max(keyWidth+1-lipgloss.Width(keyCell), 1)))
+
+		if current && kv.column == valueColumn {
+			sb.WriteString(kv.textinput.View())
+		} else {
+			sb.WriteString(styles.Option.Render(kv.mask(row.value)))
+		}
+		if kv.duplicate(i) {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+	}
+
+	return styles.Base.
+		Width(kv.width).
+		Height(kv.height).
+		Render(sb.String())
+}
+
+// Run runs the key/value field.
+func (kv *KeyValue) Run() error {
+	return Run(kv)
+}
+
+// RunAccessible runs the key/value field in accessible mode.
+//
+// Pairs are added after the current ones, a key then its value, until a blank
+// key.
+func (kv *KeyValue) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := kv.activeStyles()
+	_, _ = fmt.Fprintln(w, styles.Title.Render(cmp.Or(kv.title.val, "Pairs")))
+
+	kv.rows = slices.DeleteFunc(kv.rows, func(row keyValueRow) bool { return row.key == "" && row.value == "" })
+	for _, row := range kv.rows {
+		_, _ = fmt.Fprintf(w, "%s=%s\n", row.key, kv.mask(row.value))
+	}
+	for {
+		k := accessibility.PromptString(w, r, kv.keyTitle+" (blank to finish): ", "", func(s string) error {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return nil
+			}
+			if slices.ContainsFunc(kv.rows, func(row keyValueRow) bool { return row.key == s }) {
+				return fmt.Errorf("duplicate key %q", s)
+			}
+			return kv.validateKey(s)
+		})
+		k = strings.TrimSpace(k)
+		if k == "" {
+			break
+		}
+
+		prompt := kv.valueTitle + " of " + k + ": "
+		var value string
+		fd, ok := r.(interface{ Fd() uintptr })
+		if ok && kv.echoMode != EchoModeNormal {
+			var err error
+			if value, err = accessibility.PromptPassword(w, fd.Fd(), prompt, kv.validateValue); err != nil {
+				return err //nolint:wrapcheck
+			}
+		} else {
+			value = accessibility.PromptString(w, r, prompt, "", kv.validateValue)
+		}
+		kv.rows = append(kv.rows, keyValueRow{key: k, value: value})
+	}
+	if len(kv.rows) == 0 {
+		kv.rows = append(kv.rows, keyValueRow{})
+	}
+
+	kv.focused = true
+	kv.store()
+	kv.focused = false
+	return nil
+}
+
+// answer sets the value of the key/value field from an answers source.
+//
+// Pairs are given as a map, or as a list of KEY=VALUE strings.
+func (kv *KeyValue) answer(value any) error {
+	pairs := make(map[string]string)
+	switch v := value.(type) {
+	case map[string]string:
+		pairs = v
+	case map[string]any:
+		for k, item := range v {
+			pairs[k] = answerString(item)
+		}
+	default:
+		for _, item := range answerList(value) {
+			k, val, found := strings.Cut(item, "=")
+			if !found {
+				return fmt.Errorf("%q must be KEY=VALUE", item)
+			}
+			pairs[strings.TrimSpace(k)] = val
+		}
+	}
+	kv.accessor.Set(pairs)
+	kv.load()
+	return kv.check()
+}
+
+// review returns the title and value shown on the form's review page.
+func (kv *KeyValue) review() (string, string) {
+	pairs := make([]string, 0, len(kv.rows))
+	for _, row := range kv.rows {
+		if row.key != "" || row.value != "" {
+			pairs = append(pairs, row.key+"="+kv.mask(row.value))
+		}
+	}
+	return kv.title.val, strings.Join(pairs, ", ")
+}
+
+// WithKeyMap sets the keymap on a key/value field.
+func (kv *KeyValue) WithKeyMap(k *KeyMap) Field {
+	kv.keymap = k.KeyValue
+	return kv
+}
+
+// WithTheme sets the theme of the key/value field.
+func (kv *KeyValue) WithTheme(theme Theme) Field {
+	if kv.theme != nil {
+		return kv
+	}
+	kv.theme = theme
+	return kv
+}
+
+// WithWidth sets the width of the key/value field.
+func (kv *KeyValue) WithWidth(width int) Field {
+	kv.width = width
+	return kv
+}
+
+// WithHeight sets the height of the key/value field.
+func (kv *KeyValue) WithHeight(height int) Field {
+	kv.height = height
+	return kv
+}
+
+// WithPosition sets the position of the key/value field.
+func (kv *KeyValue) WithPosition(p FieldPosition) Field {
+	kv.keymap.Prev.SetEnabled(!p.IsFirst())
+	kv.keymap.Next.SetEnabled(!p.IsLast())
+	kv.keymap.Submit.SetEnabled(p.IsLast())
+	return kv
+}
+
+// GetKey returns the key of the field.
+func (kv *KeyValue) GetKey() string { return kv.key }
+
+// GetValue returns the value of the field.
+func (kv *KeyValue) GetValue() any {
+	return kv.accessor.Get()
+}
@@ -0,0 +1,566 @@
+package huh
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+// List is a form field to collect any number of strings, such as hostnames
+// or tags.
+//
+// New items are typed in an input under the list. Existing items can be
+// selected to edit, delete or move them.
+type List struct {
+	accessor Accessor[[]string]
+	key      string
+	hide     func() bool
+	id       int
+
+	// customization
+	title       Eval[string]
+	description Eval[string]
+
+	// error handling
+	validate     func([]string) error
+	validateItem func(string) error
+	minimum      int
+	maximum      int
+	err          error
+
+	// state
+	textinput textinput.Model
+	cursor    int // the selected item, or the number of items for the input
+	editing   int // the item being edited, or -1
+	focused   bool
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    ListKeyMap
+}
+
+// NewList returns a new list field.
+func NewList() *List {
+	input := textinput.New()
+	input.Prompt = "+ "
+
+	return &List{
+		accessor:     &EmbeddedAccessor[[]string]{},
+		id:           nextID(),
+		title:        Eval[string]{cache: make(map[uint64]string)},
+		description:  Eval[string]{cache: make(map[uint64]string)},
+		validate:     func([]string) error { return nil },
+		validateItem: func(string) error { return nil },
+		textinput:    input,
+		editing:      -1,
+	}
+}
+
+// Value sets the value of the list field.
+func (l *List) Value(value *[]string) *List {
+	return l.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the list field.
+func (l *List) Accessor(accessor Accessor[[]string]) *List {
+	l.accessor = accessor
+	l.cursor = len(l.accessor.Get())
+	return l
+}
+
+// Key sets the key of the list field.
+func (l *List) Key(key string) *List {
+	l.key = key
+	return l
+}
+
+// Title sets the title of the list field.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (l *List) Title(title string) *List {
+	l.title.val = title
+	l.title.fn = nil
+	return l
+}
+
+// TitleFunc sets the title func of the list field.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (l *List) TitleFunc(f func() string, bindings any) *List {
+	l.title.fn = f
+	l.title.bindings = bindings
+	return l
+}
+
+// Description sets the description of the list field.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (l *List) Description(description string) *List {
+	l.description.val = description
+	l.description.fn = nil
+	return l
+}
+
+// DescriptionFunc sets the description func of the list field.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (l *List) DescriptionFunc(f func() string, bindings any) *List {
+	l.description.fn = f
+	l.description.bindings = bindings
+	return l
+}
+
+// Prompt sets the prompt of the input new items are typed in.
+func (l *List) Prompt(prompt string) *List {
+	l.textinput.Prompt = prompt
+	return l
+}
+
+// Placeholder sets the placeholder of the input new items are typed in.
+func (l *List) Placeholder(str string) *List {
+	l.textinput.Placeholder = str
+	return l
+}
+
+// Min sets the fewest items the list can have.
+func (l *List) Min(n int) *List {
+	l.minimum = n
+	return l
+}
+
+// Max sets the most items the list can have. Zero means no limit.
+func (l *List) Max(n int) *List {
+	l.maximum = n
+	return l
+}
+
+// Validate sets the validation function of the whole list.
+func (l *List) Validate(validate func([]string) error) *List {
+	l.validate = validate
+	return l
+}
+
+// ValidateItem sets the validation function of each item, checked as items
+// are added or edited.
+func (l *List) ValidateItem(validate func(string) error) *List {
+	l.validateItem = validate
+	return l
+}
+
+// Error returns the error of the list field.
+func (l *List) Error() error { return l.err }
+
+// Skip returns whether the list should be skipped or should be blocking.
+func (l *List) Skip() bool { return l.hidden() }
+
+// Hide sets whether the list is hidden.
+func (l *List) Hide(hide bool) *List {
+	return l.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the list is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (l *List) HideFunc(hideFunc func() bool) *List {
+	l.hide = hideFunc
+	return l
+}
+
+// hidden returns whether the list is hidden.
+func (l *List) hidden() bool { return l.hide != nil && l.hide() }
+
+// Zoom returns whether the list should be zoomed.
+func (*List) Zoom() bool { return false }
+
+// Focus focuses the list field.
+func (l *List) Focus() tea.Cmd {
+	l.focused = true
+	l.cursor = len(l.accessor.Get())
+	l.updateKeys()
+	return l.textinput.Focus()
+}
+
+// Blur blurs the list field.
+func (l *List) Blur() tea.Cmd {
+	l.focused = false
+	l.editing = -1
+	l.textinput.Blur()
+	l.textinput.SetValue("")
+	l.err = l.check(l.accessor.Get())
+	return nil
+}
+
+// KeyBinds returns the help message for the list field.
+func (l *List) KeyBinds() []key.Binding {
+	return []key.Binding{
+		l.keymap.Add, l.keymap.Up, l.keymap.Down, l.keymap.Edit, l.keymap.Delete,
+		l.keymap.MoveUp, l.keymap.MoveDown, l.keymap.Cancel,
+		l.keymap.Prev, l.keymap.Submit, l.keymap.Next,
+	}
+}
+
+// Init initializes the list field.
+func (l *List) Init() tea.Cmd {
+	l.textinput.Blur()
+	return nil
+}
+
+// Update updates the list field.
+func (l *List) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		l.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		if ok, hash := l.title.shouldUpdate(); ok {
+			l.title.bindingsHash = hash
+			if !l.title.loadFromCache() {
+				l.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: l.id, title: l.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := l.description.shouldUpdate(); ok {
+			l.description.bindingsHash = hash
+			if !l.description.loadFromCache() {
+				l.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: l.id, description: l.description.fn(), hash: hash}
+				})
+			}
+		}
+		return l, tea.Batch(cmds...)
+	case updateTitleMsg:
+		if msg.id == l.id && msg.hash == l.title.bindingsHash {
+			l.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == l.id && msg.hash == l.description.bindingsHash {
+			l.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		l.err = nil
+		cmd, handled := l.handleKey(msg)
+		l.updateKeys()
+		if handled {
+			return l, cmd
+		}
+	}
+
+	if !l.onInput() {
+		return l, tea.Batch(cmds...)
+	}
+	var cmd tea.Cmd
+	l.textinput, cmd = l.textinput.Update(msg)
+	cmds = append(cmds, cmd)
+	return l, tea.Batch(cmds...)
+}
+
+// handleKey handles key presses, and returns whether the key press was
+// handled and shouldn't reach the input.
+func (l *List) handleKey(msg tea.KeyPressMsg) (tea.Cmd, bool) {
+	items := l.accessor.Get()
+	onItem := !l.onInput()
+
+	switch {
+	case key.Matches(msg, l.keymap.Prev):
+		return PrevField, true
+	case !onItem && key.Matches(msg, l.keymap.Add) && strings.TrimSpace(l.textinput.Value()) != "":
+		l.commit()
+	case l.editing >= 0 && key.Matches(msg, l.keymap.Cancel):
+		l.cursor = l.editing
+		l.editing = -1
+		l.textinput.SetValue("")
+		l.textinput.Blur()
+	case l.editing < 0 && key.Matches(msg, l.keymap.Up):
+		l.moveCursor(l.cursor - 1)
+	case l.editing < 0 && key.Matches(msg, l.keymap.Down):
+		l.moveCursor(l.cursor + 1)
+	case onItem && key.Matches(msg, l.keymap.Edit):
+		l.editing = l.cursor
+		l.textinput.SetValue(items[l.cursor])
+		l.textinput.CursorEnd()
+		return l.textinput.Focus(), true
+	case onItem && key.Matches(msg, l.keymap.Delete):
+		l.accessor.Set(slices.Delete(slices.Clone(items), l.cursor, l.cursor+1))
+		l.moveCursor(min(l.cursor, len(items)-2)) //nolint:mnd
+	case onItem && key.Matches(msg, l.keymap.MoveUp):
+		l.swap(l.cursor, l.cursor-1)
+	case onItem && key.Matches(msg, l.keymap.MoveDown):
+		l.swap(l.cursor, l.cursor+1)
+	case l.editing < 0 && key.Matches(msg, l.keymap.Next, l.keymap.Submit):
+		// a typed item isn't lost when moving on.
+		if strings.TrimSpace(l.textinput.Value()) != "" {
+			if l.commit(); l.err != nil {
+				return nil, true
+			}
+		}
+		if l.err = l.check(l.accessor.Get()); l.err != nil {
+			return nil, true
+		}
+		return NextField, true
+	default:
+		return nil, onItem
+	}
+	return nil, true
+}
+
+// onInput returns whether the input has the focus, to add or edit an item.
+func (l *List) onInput() bool {
+	return l.editing >= 0 || l.cursor >= len(l.accessor.Get())
+}
+
+// moveCursor selects the item at i, or the input past the last item.
+func (l *List) moveCursor(i int) {
+	l.cursor = min(max(i, 0), len(l.accessor.Get()))
+	if l.onInput() {
+		l.textinput.Focus()
+	} else {
+		l.textinput.Blur()
+	}
+}
+
+// swap swaps the item at i with the item at j, following it with the cursor.
+func (l *List) swap(i, j int) {
+	items := slices.Clone(l.accessor.Get())
+	if j < 0 || j >= len(items) {
+		return
+	}
+	items[i], items[j] = items[j], items[i]
+	l.accessor.Set(items)
+	l.cursor = j
+}
+
+// commit adds the typed item, or replaces the edited one.
+func (l *List) commit() {
+	item := strings.TrimSpace(l.textinput.Value())
+	if l.err = l.validateItem(item); l.err != nil {
+		return
+	}
+	items := slices.Clone(l.accessor.Get())
+	if l.editing >= 0 {
+		items[l.editing] = item
+		l.cursor = l.editing
+		l.editing = -1
+		l.textinput.Blur()
+	} else {
+		if l.maximum > 0 && len(items) >= l.maximum {
+			l.err = fmt.Errorf("at most %d items", l.maximum)
+			return
+		}
+		items = append(items, item)
+		l.cursor = len(items)
+	}
+	l.accessor.Set(items)
+	l.textinput.SetValue("")
+}
+
+// updateKeys enables the keys that apply to the input or to the selected item.
+func (l *List) updateKeys() {
+	onItem := !l.onInput()
+	l.keymap.Add.SetEnabled(!onItem)
+	l.keymap.Edit.SetEnabled(onItem)
+	l.keymap.Delete.SetEnabled(onItem)
+	l.keymap.MoveUp.SetEnabled(onItem)
+	l.keymap.MoveDown.SetEnabled(onItem)
+	l.keymap.Cancel.SetEnabled(l.editing >= 0)
+	if l.editing >= 0 {
+		l.keymap.Add.SetHelp("enter", "save")
+	} else {
+		l.keymap.Add.SetHelp("enter", "add")
+	}
+}
+
+// check checks the number of items and the validation function.
+func (l *List) check(items []string) error {
+	if len(items) < l.minimum {
+		return fmt.Errorf("at least %d items", l.minimum)
+	}
+	if l.maximum > 0 && len(items) > l.maximum {
+		return fmt.Errorf("at most %d items", l.maximum)
+	}
+	return l.validate(items)
+}
+
+func (l *List) activeStyles() *FieldStyles {
+	theme := l.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if l.focused {
+		return &theme.Theme(l.hasDarkBg).Focused
+	}
+	return &theme.Theme(l.hasDarkBg).Blurred
+}
+
+// View renders the list field.
+func (l *List) View() string {
+	styles := l.activeStyles()
+	maxWidth := l.width - styles.Base.GetHorizontalFrameSize()
+
+	st := l.textinput.Styles()
+	st.Cursor.Color = styles.TextInput.Cursor.GetForeground()
+	st.Focused.Prompt = styles.TextInput.Prompt
+	st.Focused.Text = styles.TextInput.Text
+	st.Focused.Placeholder = styles.TextInput.Placeholder
+	l.textinput.SetStyles(st)
+
+	var sb strings.Builder
+	if l.title.val != "" || l.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(l.title.val, maxWidth)))
+		if l.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if l.description.val != "" || l.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(l.description.val, maxWidth)))
+		sb.WriteString("\n")
+	}
+
+	selector := styles.SelectSelector.String()
+	indent := strings.Repeat(" ", lipgloss.Width(selector))
+	for i, item := range l.accessor.Get() {
+		switch {
+		case i == l.editing:
+			sb.WriteString(indent + l.textinput.View())
+		case i == l.cursor && l.focused:
+			sb.WriteString(selector + styles.SelectedOption.Render(item))
+		default:
+			sb.WriteString(indent + styles.Option.Render(item))
+		}
+		sb.WriteString("\n")
+	}
+	if l.editing < 0 {
+		sb.WriteString(indent + l.textinput.View())
+	}
+
+	return styles.Base.
+		Width(l.width).
+		Height(l.height).
+		Render(strings.TrimSuffix(sb.String(), "\n"))
+}
+
+// Run runs the list field.
+func (l *List) Run() error {
+	return Run(l)
+}
+
+// RunAccessible runs the list field in accessible mode.
+//
+// Items are typed one per line, after the current ones, until a blank line.
+func (l *List) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := l.activeStyles()
+	title := cmp.Or(l.title.val, "Items")
+	_, _ = fmt.Fprintln(w, styles.Title.Render(title))
+
+	items := slices.Clone(l.accessor.Get())
+	for _, item := range items {
+		_, _ = fmt.Fprintln(w, "- "+item)
+	}
+	for l.maximum == 0 || len(items) < l.maximum {
+		prompt := fmt.Sprintf("Item %d (blank to finish): ", len(items)+1)
+		item := accessibility.PromptString(w, r, prompt, "", func(s string) error {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return l.check(items)
+			}
+			return l.validateItem(s)
+		})
+		item = strings.TrimSpace(item)
+		if item == "" {
+			break
+		}
+		items = append(items, item)
+	}
+	l.accessor.Set(items)
+	return nil
+}
+
+// answer sets the value of the list field from an answers source.
+func (l *List) answer(value any) error {
+	items := answerList(value)
+	for _, item := range items {
+		if err := l.validateItem(item); err != nil {
+			return fmt.Errorf("%q: %w", item, err)
+		}
+	}
+	l.accessor.Set(items)
+	return l.check(l.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (l *List) review() (string, string) {
+	return l.title.val, strings.Join(l.accessor.Get(), ", ")
+}
+
+// WithKeyMap sets the keymap on a list field.
+func (l *List) WithKeyMap(k *KeyMap) Field {
+	l.keymap = k.List
+	l.updateKeys()
+	return l
+}
+
+// WithTheme sets the theme of the list field.
+func (l *List) WithTheme(theme Theme) Field {
+	if l.theme != nil {
+		return l
+	}
+	l.theme = theme
+	return l
+}
+
+// WithWidth sets the width of the list field.
+func (l *List) WithWidth(width int) Field {
+	styles := l.activeStyles()
+	l.width = width
+	frameSize := styles.Base.GetHorizontalFrameSize()
+	promptWidth := lipgloss.Width(l.textinput.Prompt)
+	indent := lipgloss.Width(styles.SelectSelector.String())
+	l.textinput.SetWidth(width - frameSize - promptWidth - indent - 1)
+	return l
+}
+
+// WithHeight sets the height of the list field.
+func (l *List) WithHeight(height int) Field {
+	l.height = height
+	return l
+}
+
+// WithPosition sets the position of the list field.
+func (l *List) WithPosition(p FieldPosition) Field {
+	l.keymap.Prev.SetEnabled(!p.IsFirst())
+	l.keymap.Next.SetEnabled(!p.IsLast())
+	l.keymap.Submit.SetEnabled(p.IsLast())
+	return l
+}
+
+// GetKey returns the key of the field.
+func (l *List) GetKey() string { return l.key }
+
+// GetValue returns the value of the field.
+func (l *List) GetValue() any {
+	return l.accessor.Get()
+}
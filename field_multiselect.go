@@ -22,6 +22,7 @@ import (
 type MultiSelect[T comparable] struct {
 	accessor Accessor[[]T]
 	key      string
+	hide     func() bool
 	id       int
 
 	// customization
@@ -35,6 +36,11 @@ type MultiSelect[T comparable] struct {
 	// error handling
 	validate func([]T) error
 	err      error
+	other    *otherInput
+
+	// validateOther is kept for Other, which may be called after
+	// ValidateOther.
+	validateOther func(string) error
 
 	// state
 	cursor    int
@@ -82,10 +88,11 @@ func (m *MultiSelect[T]) Value(value *[]T) *MultiSelect[T] {
 func (m *MultiSelect[T]) Accessor(accessor Accessor[[]T]) *MultiSelect[T] {
 	m.accessor = accessor
 	for i, o := range m.options.val {
-		if slices.Contains(m.accessor.Get(), o.Value) {
+		if !o.other && slices.Contains(m.accessor.Get(), o.Value) {
 			m.options.val[i].selected = true
 		}
 	}
+	m.selectOther()
 	return m
 }
 
@@ -129,8 +136,8 @@ func (m *MultiSelect[T]) Options(options ...Option[T]) *MultiSelect[T] {
 		return m
 	}
 
-	m.options.val = options
-	m.filteredOptions = options
+	m.options.val = withOther(options, m.other, m.otherSelected())
+	m.filteredOptions = m.options.val
 	m.selectOptions()
 	m.updateViewportSize()
 	return m
@@ -140,20 +147,83 @@ func (m *MultiSelect[T]) selectOptions() {
 	// Set the cursor to the existing value or the last selected option.
 	for i, o := range m.options.val {
 		for _, v := range m.accessor.Get() {
-			if o.Value == v {
+			if !o.other && o.Value == v {
 				m.options.val[i].selected = true
 			}
 		}
 	}
+	m.selectOther()
 
 	for i, o := range m.options.val {
 		if !o.selected {
 			continue
 		}
 		m.cursor = i
-		m.ensureCursorVisible()
 		break
 	}
+	m.cursor = skipDisabled(m.options.val, m.cursor, 1, false)
+	m.ensureCursorVisible()
+}
+
+// selectOther selects the "Other…" option, with the first value that isn't
+// among the options as its text, if there's one. The other values that aren't
+// among the options are added as selected options before it, so that none is
+// lost.
+func (m *MultiSelect[T]) selectOther() {
+	if m.other == nil {
+		return
+	}
+	other := m.otherSelected()
+	for _, v := range m.accessor.Get() {
+		switch {
+		case isOption(m.options.val, v):
+		case !other && m.other.setValue(v):
+			m.options.val[len(m.options.val)-1].selected = true
+			other = true
+		case any(v) != any(otherValue[T](m.other)):
+			option := Option[T]{Key: answerString(v), Value: v, selected: true}
+			m.options.val = slices.Insert(m.options.val, len(m.options.val)-1, option)
+			if !m.filtering {
+				m.filteredOptions = m.options.val
+			}
+		}
+	}
+}
+
+// otherSelected returns whether the "Other…" option is selected.
+func (m *MultiSelect[T]) otherSelected() bool {
+	return m.other != nil && len(m.options.val) > 0 && m.options.val[len(m.options.val)-1].selected
+}
+
+// Other appends an "Other…" option, labeled as given, to enter an answer that
+// isn't among the options. Selecting it reveals an input for the answer, which
+// is validated by ValidateOther. The first value that isn't among the options
+// is shown as the answer of this option, and the others are added as selected
+// options.
+//
+// It only applies to MultiSelect[string], and is ignored otherwise.
+func (m *MultiSelect[T]) Other(label string) *MultiSelect[T] {
+	if !acceptsOther[T]() {
+		return m
+	}
+	m.other = newOtherInput(label, m.validateOther)
+	m.options.val = withOther(m.options.val, m.other, false)
+	m.filteredOptions = m.options.val
+	m.selectOptions()
+	m.updateViewportSize()
+	return m
+}
+
+// ValidateOther sets the validation function of the answer of the "Other…"
+// option. Defaults to ValidateNotEmpty.
+//
+// It may be called before or after Other.
+func (m *MultiSelect[T]) ValidateOther(validate func(string) error) *MultiSelect[T] {
+	m.validateOther = validate
+	if m.other != nil {
+		m.other.validate = validate
+	}
+	return m
 }
 
 // OptionsFunc sets the options func of the multi-select field.
@@ -223,10 +293,25 @@ func (m *MultiSelect[T]) Error() error {
 }
 
 // Skip returns whether the multiselect should be skipped or should be blocking.
-func (*MultiSelect[T]) Skip() bool {
-	return false
+func (m *MultiSelect[T]) Skip() bool { return m.hidden() }
+
+// Hide sets whether the multi-select is hidden.
+func (m *MultiSelect[T]) Hide(hide bool) *MultiSelect[T] {
+	return m.HideFunc(func() bool { return hide })
 }
 
+// HideFunc sets the function that checks whether the multi-select is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (m *MultiSelect[T]) HideFunc(hideFunc func() bool) *MultiSelect[T] {
+	m.hide = hideFunc
+	return m
+}
+
+// hidden returns whether the multi-select is hidden.
+func (m *MultiSelect[T]) hidden() bool { return m.hide != nil && m.hide() }
+
 // Zoom returns whether the multiselect should be zoomed.
 func (*MultiSelect[T]) Zoom() bool {
 	return false
@@ -241,6 +326,9 @@ func (m *MultiSelect[T]) Focus() tea.Cmd {
 
 // Blur blurs the multi-select field.
 func (m *MultiSelect[T]) Blur() tea.Cmd {
+	if m.other != nil {
+		m.other.stopEditing()
+	}
 	m.updateValue()
 	m.focused = false
 	return nil
@@ -254,11 +342,26 @@ func (m *MultiSelect[T]) Hovered() (T, bool) {
 		var zero T
 		return zero, false
 	}
+	if m.filteredOptions[m.cursor].other {
+		return otherValue[T](m.other), true
+	}
 	return m.filteredOptions[m.cursor].Value, true
 }
 
+// HoveredKey returns the key, the displayed label, of the option under the
+// cursor, and a bool indicating whether one was found.
+func (m *MultiSelect[T]) HoveredKey() (string, bool) {
+	if len(m.filteredOptions) == 0 || m.cursor >= len(m.filteredOptions) {
+		return "", false
+	}
+	return m.filteredOptions[m.cursor].Key, true
+}
+
 // KeyBinds returns the help message for the multi-select field.
 func (m *MultiSelect[T]) KeyBinds() []key.Binding {
+	if m.other != nil && m.other.editing {
+		return []key.Binding{m.keymap.Prev, m.keymap.Next, m.keymap.Submit}
+	}
 	m.setSelectAllHelp()
 	binds := []key.Binding{
 		m.keymap.Toggle,
@@ -303,6 +406,10 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 		m.setSelectAllHelp()
 		cmds = append(cmds, cmd)
 	}
+	if _, ok := msg.(tea.KeyPressMsg); !ok && m.other != nil && m.other.editing {
+		m.other.input, cmd = m.other.input.Update(msg)
+		cmds = append(cmds, cmd)
+	}
 
 	switch msg := msg.(type) {
 	case tea.BackgroundColorMsg:
@@ -330,9 +437,11 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 		if ok, hash := m.options.shouldUpdate(); ok {
 			m.options.bindingsHash = hash
 			if m.options.loadFromCache() {
+				m.options.val = withOther(m.options.val, m.other, m.otherSelected())
 				m.filteredOptions = m.options.val
 				m.updateValue()
 				m.cursor = ordered.Clamp(m.cursor, 0, len(m.filteredOptions)-1)
+				m.cursor = skipDisabled(m.filteredOptions, m.cursor, 1, false)
 			} else {
 				m.options.loading = true
 				m.options.loadingStart = time.Now()
@@ -361,15 +470,21 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 	case updateOptionsMsg[T]:
 		if msg.id == m.id && msg.hash == m.options.bindingsHash {
+			selected := m.otherSelected()
 			m.options.update(msg.options)
+			m.options.val = withOther(m.options.val, m.other, selected)
 			m.selectOptions()
 			// since we're updating the options, we need to reset the cursor.
 			m.filteredOptions = m.options.val
 			m.updateValue()
 			m.cursor = ordered.Clamp(m.cursor, 0, len(m.filteredOptions)-1)
+			m.cursor = skipDisabled(m.filteredOptions, m.cursor, 1, false)
 		}
 	case tea.KeyPressMsg:
 		m.err = nil
+		if m.other != nil && m.other.editing {
+			return m.updateOther(msg)
+		}
 		switch {
 		case key.Matches(msg, m.keymap.Filter):
 			m.setFilter(true)
@@ -391,7 +506,7 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 				break
 			}
 
-			m.cursor = max(m.cursor-1, 0)
+			m.cursor = skipDisabled(m.filteredOptions, max(m.cursor-1, 0), -1, false)
 			m.ensureCursorVisible()
 		case key.Matches(msg, m.keymap.Down):
 			//nolint:godox
@@ -400,26 +515,29 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 				break
 			}
 
-			m.cursor = min(m.cursor+1, len(m.filteredOptions)-1)
+			m.cursor = skipDisabled(m.filteredOptions, min(m.cursor+1, len(m.filteredOptions)-1), 1, false)
 			m.ensureCursorVisible()
 		case key.Matches(msg, m.keymap.GotoTop):
 			if m.filtering {
 				break
 			}
-			m.cursor = 0
+			m.cursor = skipDisabled(m.filteredOptions, 0, 1, false)
 			m.viewport.GotoTop()
 		case key.Matches(msg, m.keymap.GotoBottom):
 			if m.filtering {
 				break
 			}
-			m.cursor = len(m.filteredOptions) - 1
+			m.cursor = skipDisabled(m.filteredOptions, len(m.filteredOptions)-1, -1, false)
 			m.viewport.GotoBottom()
 		case key.Matches(msg, m.keymap.HalfPageUp):
-			m.cursor = max(m.cursor-m.viewport.Height()/2, 0)
+			m.cursor = skipDisabled(m.filteredOptions, max(m.cursor-m.viewport.Height()/2, 0), -1, false)
 			m.ensureCursorVisible()
 		case key.Matches(msg, m.keymap.HalfPageDown):
-			m.cursor = min(m.cursor+m.viewport.Height()/2, len(m.filteredOptions)-1)
+			m.cursor = skipDisabled(m.filteredOptions, min(m.cursor+m.viewport.Height()/2, len(m.filteredOptions)-1), 1, false)
 			m.ensureCursorVisible()
+		case key.Matches(msg, m.keymap.Toggle) && !m.filtering &&
+			m.cursor < len(m.filteredOptions) && m.filteredOptions[m.cursor].disabled:
+			m.err = m.filteredOptions[m.cursor].disabledError()
 		case key.Matches(msg, m.keymap.Toggle) && !m.filtering:
 			for i, option := range m.options.val {
 				if option.Key == m.filteredOptions[m.cursor].Key {
@@ -433,17 +551,23 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 			}
 			m.setSelectAllHelp()
 			m.updateValue()
+			if option := m.filteredOptions[m.cursor]; option.other && option.selected {
+				return m, m.other.edit()
+			}
 		case key.Matches(msg, m.keymap.SelectAll, m.keymap.SelectNone) && m.limit <= 0:
 			selected := false
 
 			for _, option := range m.filteredOptions {
-				if !option.selected {
+				if !option.selected && !option.disabled {
 					selected = true
 					break
 				}
 			}
 
 			for i, option := range m.options.val {
+				if option.disabled {
+					continue
+				}
 				for j := range m.filteredOptions {
 					if option.Key == m.filteredOptions[j].Key {
 						m.options.val[i].selected = selected
@@ -456,14 +580,14 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 			m.updateValue()
 		case key.Matches(msg, m.keymap.Prev):
 			m.updateValue()
-			m.err = m.validate(m.accessor.Get())
+			m.err = m.check(m.accessor.Get())
 			if m.err != nil {
 				return m, nil
 			}
 			return m, PrevField
 		case key.Matches(msg, m.keymap.Next, m.keymap.Submit):
 			m.updateValue()
-			m.err = m.validate(m.accessor.Get())
+			m.err = m.check(m.accessor.Get())
 			if m.err != nil {
 				return m, nil
 			}
@@ -481,7 +605,7 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 				}
 			}
 			if len(m.filteredOptions) > 0 {
-				m.cursor = min(m.cursor, len(m.filteredOptions)-1)
+				m.cursor = skipDisabled(m.filteredOptions, min(m.cursor, len(m.filteredOptions)-1), 1, false)
 			}
 		}
 		m.ensureCursorVisible()
@@ -490,6 +614,39 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// updateOther updates the answer of the "Other…" option being edited.
+func (m *MultiSelect[T]) updateOther(msg tea.KeyPressMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keymap.Next, m.keymap.Submit, m.keymap.Prev):
+		if m.err = m.other.validate(m.other.input.Value()); m.err != nil {
+			return m, nil
+		}
+		m.other.stopEditing()
+		m.updateValue()
+		return m, nil
+	case m.other.leaves(msg, m.keymap.Up, m.keymap.Down):
+		m.other.stopEditing()
+		m.updateValue()
+		return m.Update(msg)
+	}
+
+	var cmd tea.Cmd
+	m.other.input, cmd = m.other.input.Update(msg)
+	m.updateValue()
+	return m, cmd
+}
+
+// check checks the answer of the "Other…" option if it's selected, then the
+// validation function.
+func (m *MultiSelect[T]) check(values []T) error {
+	if m.otherSelected() {
+		if err := m.other.validate(m.other.input.Value()); err != nil {
+			return err
+		}
+	}
+	return m.validate(values)
+}
+
 // updateViewportSize updates the viewport size according to the Height setting
 // on this multi-select field.
 func (m *MultiSelect[T]) updateViewportSize() {
@@ -540,7 +697,11 @@ func (m *MultiSelect[T]) numFilteredSelected() int {
 func (m *MultiSelect[T]) updateValue() {
 	value := make([]T, 0)
 	for _, option := range m.options.val {
-		if option.selected {
+		switch {
+		case !option.selected:
+		case option.other:
+			value = append(value, otherValue[T](m.other))
+		default:
 			value = append(value, option.Value)
 		}
 	}
@@ -598,13 +759,22 @@ func (m *MultiSelect[T]) renderOption(option Option[T], cursor, selected bool) s
 	} else {
 		parts = append(parts, strings.Repeat(" ", lipgloss.Width(styles.MultiSelectSelector.String())))
 	}
-	if selected {
+	key := option.Key
+	if option.other && selected {
+		key = m.other.view(styles)
+	}
+	switch {
+	case selected:
 		parts = append(parts, styles.SelectedPrefix.String())
-		parts = append(parts, styles.SelectedOption.Render(option.Key))
-	} else {
+		parts = append(parts, styles.SelectedOption.Render(key))
+	case option.disabled:
 		parts = append(parts, styles.UnselectedPrefix.String())
-		parts = append(parts, styles.UnselectedOption.Render(option.Key))
+		parts = append(parts, styles.DisabledOption.Render(key))
+	default:
+		parts = append(parts, styles.UnselectedPrefix.String())
+		parts = append(parts, styles.UnselectedOption.Render(key))
 	}
+	parts = append(parts, optionSuffix(option, styles))
 	return lipgloss.JoinHorizontal(lipgloss.Left, parts...)
 }
 
@@ -687,10 +857,14 @@ func (m *MultiSelect[T]) printOptions(w io.Writer) {
 	styles := m.activeStyles()
 	var sb strings.Builder
 	for i, option := range m.options.val {
+		key := option.accessibleString()
+		if option.other && option.selected {
+			key = m.other.view(styles)
+		}
 		if option.selected {
-			sb.WriteString(styles.SelectedOption.Render(fmt.Sprintf("%d. %s %s", i+1, "✓", option.Key)))
+			sb.WriteString(styles.SelectedOption.Render(fmt.Sprintf("%d. %s %s", i+1, "✓", key)))
 		} else {
-			_, _ = fmt.Fprintf(&sb, "%d.   %s", i+1, option.Key)
+			_, _ = fmt.Fprintf(&sb, "%d.   %s", i+1, key)
 		}
 		sb.WriteString("\n")
 	}
@@ -756,7 +930,7 @@ func (m *MultiSelect[T]) RunAccessible(w io.Writer, r io.Reader) error {
 		choice = accessibility.PromptInt(w, r, prompt, 0, len(m.options.val), nil)
 		if choice <= 0 {
 			m.updateValue()
-			err := m.validate(m.accessor.Get())
+			err := m.check(m.accessor.Get())
 			if err != nil {
 				_, _ = fmt.Fprintln(w, err)
 				continue
@@ -764,18 +938,85 @@ func (m *MultiSelect[T]) RunAccessible(w io.Writer, r io.Reader) error {
 			break
 		}
 
+		if option := m.options.val[choice-1]; option.disabled {
+			_, _ = fmt.Fprintln(w, option.disabledError())
+			_, _ = fmt.Fprintln(w)
+			continue
+		}
 		if !m.options.val[choice-1].selected && m.limit > 0 && m.numSelected() >= m.limit {
 			_, _ = fmt.Fprintf(w, "You can't select more than %d options.\n", m.limit)
 			_, _ = fmt.Fprintln(w)
 			continue
 		}
 		m.options.val[choice-1].selected = !m.options.val[choice-1].selected
+		if option := m.options.val[choice-1]; option.other && option.selected {
+			text := accessibility.PromptString(w, r, m.other.label+" ", m.other.input.Value(), m.other.validate)
+			m.other.input.SetValue(text)
+		}
 		_, _ = fmt.Fprintln(w)
 	}
 
 	return nil
 }
 
+// answer sets the value of the multi-select field from an answers source.
+//
+// The answer is a list of option values, keys, or values formatted as
+// strings. A string is treated as a comma separated list.
+func (m *MultiSelect[T]) answer(value any) error {
+	options := withoutOther(m.options.val)
+	if m.options.fn != nil {
+		options = m.options.fn()
+	}
+
+	values := make([]T, 0)
+	for _, item := range answerList(value) {
+		option, err := answerOption(options, item)
+		if err != nil {
+			// answers that aren't among the options are the ones of
+			// the "Other…" option, if there's one.
+			if m.other == nil || answerString(item) == "" {
+				return err
+			}
+			if err := m.other.validate(answerString(item)); err != nil {
+				return err
+			}
+			option.Value, _ = any(answerString(item)).(T)
+		}
+		if option.disabled {
+			return option.disabledError()
+		}
+		values = append(values, option.Value)
+	}
+	if m.limit > 0 && len(values) > m.limit {
+		return fmt.Errorf("can't select more than %d options", m.limit)
+	}
+	m.accessor.Set(values)
+	for i, o := range m.options.val {
+		m.options.val[i].selected = !o.other && slices.Contains(values, o.Value)
+	}
+	if m.other != nil {
+		m.other.input.SetValue("")
+	}
+	m.selectOther()
+	return m.validate(m.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (m *MultiSelect[T]) review() (string, string) {
+	var keys []string
+	for _, option := range m.options.val {
+		switch {
+		case !option.selected:
+		case option.other:
+			keys = append(keys, m.other.input.Value())
+		default:
+			keys = append(keys, option.Key)
+		}
+	}
+	return m.title.val, strings.Join(keys, ", ")
+}
+
 // WithTheme sets the theme of the multi-select field.
 func (m *MultiSelect[T]) WithTheme(theme Theme) Field {
 	if m.theme != nil {
@@ -24,6 +24,7 @@ type Note struct {
 	focused        bool
 	showNextButton bool
 	skip           bool
+	hide           func() bool
 
 	height int
 	width  int
@@ -143,7 +144,24 @@ func (n *Note) Blur() tea.Cmd {
 func (n *Note) Error() error { return nil }
 
 // Skip returns whether the note should be skipped or should be blocking.
-func (n *Note) Skip() bool { return n.skip }
+func (n *Note) Skip() bool { return n.skip || n.hidden() }
+
+// Hide sets whether the note is hidden.
+func (n *Note) Hide(hide bool) *Note {
+	return n.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the note is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (n *Note) HideFunc(hideFunc func() bool) *Note {
+	n.hide = hideFunc
+	return n
+}
+
+// hidden returns whether the note is hidden.
+func (n *Note) hidden() bool { return n.hide != nil && n.hide() }
 
 // Zoom returns whether the note should be zoomed.
 func (n *Note) Zoom() bool { return false }
@@ -0,0 +1,580 @@
+package huh
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+// Number is a numeric input field.
+//
+// The number field is a field that allows the user to enter an integer or a
+// float. Keystrokes that can't make a number are rejected, and the value can
+// be stepped with the up and down keys, within the Min and Max bounds.
+type Number[T int | int64 | float64] struct {
+	accessor Accessor[T]
+	key      string
+	hide     func() bool
+	id       int
+
+	title       Eval[string]
+	description Eval[string]
+
+	textinput textinput.Model
+
+	minimum   *T
+	maximum   *T
+	step      T
+	precision int
+
+	inline   bool
+	validate func(T) error
+	err      error
+	focused  bool
+
+	width  int
+	height int
+
+	theme     Theme
+	hasDarkBg bool
+	keymap    NumberKeyMap
+}
+
+// NewNumber creates a new number field.
+//
+// The number field is a field that allows the user to enter an integer or a
+// float. Keystrokes that can't make a number are rejected, and the value can
+// be stepped with the up and down keys, within the Min and Max bounds.
+func NewNumber[T int | int64 | float64]() *Number[T] {
+	input := textinput.New()
+
+	n := &Number[T]{
+		accessor:    &EmbeddedAccessor[T]{},
+		textinput:   input,
+		step:        1,
+		precision:   -1,
+		validate:    func(T) error { return nil },
+		id:          nextID(),
+		title:       Eval[string]{cache: make(map[uint64]string)},
+		description: Eval[string]{cache: make(map[uint64]string)},
+	}
+	n.setText(n.accessor.Get())
+
+	return n
+}
+
+// Value sets the value of the number field.
+func (n *Number[T]) Value(value *T) *Number[T] {
+	return n.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the number field.
+func (n *Number[T]) Accessor(accessor Accessor[T]) *Number[T] {
+	n.accessor = accessor
+	n.setText(n.accessor.Get())
+	return n
+}
+
+// Key sets the key of the number field.
+func (n *Number[T]) Key(key string) *Number[T] {
+	n.key = key
+	return n
+}
+
+// Title sets the title of the number field.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (n *Number[T]) Title(title string) *Number[T] {
+	n.title.val = title
+	n.title.fn = nil
+	return n
+}
+
+// Description sets the description of the number field.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (n *Number[T]) Description(description string) *Number[T] {
+	n.description.val = description
+	n.description.fn = nil
+	return n
+}
+
+// TitleFunc sets the title func of the number field.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (n *Number[T]) TitleFunc(f func() string, bindings any) *Number[T] {
+	n.title.fn = f
+	n.title.bindings = bindings
+	return n
+}
+
+// DescriptionFunc sets the description func of the number field.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (n *Number[T]) DescriptionFunc(f func() string, bindings any) *Number[T] {
+	n.description.fn = f
+	n.description.bindings = bindings
+	return n
+}
+
+// Prompt sets the prompt of the number field.
+func (n *Number[T]) Prompt(prompt string) *Number[T] {
+	n.textinput.Prompt = prompt
+	return n
+}
+
+// Placeholder sets the placeholder of the number field.
+func (n *Number[T]) Placeholder(str string) *Number[T] {
+	n.textinput.Placeholder = str
+	return n
+}
+
+// Min sets the smallest value allowed.
+func (n *Number[T]) Min(minimum T) *Number[T] {
+	n.minimum = &minimum
+	return n
+}
+
+// Max sets the largest value allowed.
+func (n *Number[T]) Max(maximum T) *Number[T] {
+	n.maximum = &maximum
+	return n
+}
+
+// Step sets how much the up and down keys change the value. Defaults to 1.
+func (n *Number[T]) Step(step T) *Number[T] {
+	n.step = step
+	return n
+}
+
+// Precision sets the number of decimals floats are rounded to. Integers
+// ignore it.
+func (n *Number[T]) Precision(precision int) *Number[T] {
+	n.precision = precision
+	n.setText(n.accessor.Get())
+	return n
+}
+
+// Inline sets whether the title and input should be on the same line.
+func (n *Number[T]) Inline(inline bool) *Number[T] {
+	n.inline = inline
+	return n
+}
+
+// Validate sets the validation function of the number field.
+func (n *Number[T]) Validate(validate func(T) error) *Number[T] {
+	n.validate = validate
+	return n
+}
+
+// Error returns the error of the number field.
+func (n *Number[T]) Error() error { return n.err }
+
+// Skip returns whether the number should be skipped or should be blocking.
+func (n *Number[T]) Skip() bool { return n.hidden() }
+
+// Hide sets whether the number is hidden.
+func (n *Number[T]) Hide(hide bool) *Number[T] {
+	return n.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the number is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (n *Number[T]) HideFunc(hideFunc func() bool) *Number[T] {
+	n.hide = hideFunc
+	return n
+}
+
+// hidden returns whether the number is hidden.
+func (n *Number[T]) hidden() bool { return n.hide != nil && n.hide() }
+
+// Zoom returns whether the number should be zoomed.
+func (*Number[T]) Zoom() bool { return false }
+
+// Focus focuses the number field.
+func (n *Number[T]) Focus() tea.Cmd {
+	n.focused = true
+	return n.textinput.Focus()
+}
+
+// Blur blurs the number field.
+func (n *Number[T]) Blur() tea.Cmd {
+	n.focused = false
+	n.textinput.Blur()
+	value, err := n.parse(n.textinput.Value())
+	if err != nil {
+		n.err = err
+		return nil
+	}
+	n.accessor.Set(value)
+	n.textinput.SetValue(n.format(value))
+	n.err = n.check(value)
+	return nil
+}
+
+// KeyBinds returns the help message for the number field.
+func (n *Number[T]) KeyBinds() []key.Binding {
+	return []key.Binding{n.keymap.Increment, n.keymap.Decrement, n.keymap.Prev, n.keymap.Submit, n.keymap.Next}
+}
+
+// Init initializes the number field.
+func (n *Number[T]) Init() tea.Cmd {
+	n.textinput.Blur()
+	return nil
+}
+
+// Update updates the number field.
+func (n *Number[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd //nolint:prealloc
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		n.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		var cmds []tea.Cmd
+		if ok, hash := n.title.shouldUpdate(); ok {
+			n.title.bindingsHash = hash
+			if !n.title.loadFromCache() {
+				n.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: n.id, title: n.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := n.description.shouldUpdate(); ok {
+			n.description.bindingsHash = hash
+			if !n.description.loadFromCache() {
+				n.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: n.id, description: n.description.fn(), hash: hash}
+				})
+			}
+		}
+		return n, tea.Batch(cmds...)
+	case updateTitleMsg:
+		if n.id == msg.id && n.title.bindingsHash == msg.hash {
+			n.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if n.id == msg.id && n.description.bindingsHash == msg.hash {
+			n.description.update(msg.description)
+		}
+	case tea.PasteMsg:
+		if !n.accepts(msg.Content) {
+			return n, nil
+		}
+	case tea.KeyPressMsg:
+		n.err = nil
+
+		switch {
+		case key.Matches(msg, n.keymap.Prev):
+			cmds = append(cmds, PrevField)
+		case key.Matches(msg, n.keymap.Next, n.keymap.Submit):
+			value, err := n.parse(n.textinput.Value())
+			if err != nil {
+				n.err = err
+				return n, nil
+			}
+			if n.err = n.check(value); n.err != nil {
+				return n, nil
+			}
+			cmds = append(cmds, NextField)
+		case key.Matches(msg, n.keymap.Increment):
+			n.stepBy(n.step)
+			return n, nil
+		case key.Matches(msg, n.keymap.Decrement):
+			n.stepBy(-n.step)
+			return n, nil
+		case msg.Text != "" && !n.accepts(msg.Text):
+			return n, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	n.textinput, cmd = n.textinput.Update(msg)
+	cmds = append(cmds, cmd)
+	if value, err := n.parse(n.textinput.Value()); err == nil {
+		n.accessor.Set(value)
+	}
+
+	return n, tea.Batch(cmds...)
+}
+
+// accepts returns whether typing s at the cursor can still make a number.
+func (n *Number[T]) accepts(s string) bool {
+	value := n.textinput.Value()
+	pos := n.textinput.Position()
+	next := value[:pos] + s + value[pos:]
+	if next == "-" || next == "." || next == "-." {
+		return true
+	}
+	if strings.HasSuffix(next, ".") && isFloat[T]() {
+		next += "0"
+	}
+	_, err := parseNumber[T](next)
+	return err == nil
+}
+
+// setText sets the text of the input to the value. A zero value is left
+// blank, so typing doesn't append to it.
+func (n *Number[T]) setText(value T) {
+	if value == 0 {
+		n.textinput.SetValue("")
+		return
+	}
+	n.textinput.SetValue(n.format(value))
+}
+
+// stepBy changes the value by delta, within the bounds.
+func (n *Number[T]) stepBy(delta T) {
+	value, err := n.parse(n.textinput.Value())
+	if err != nil {
+		value = n.accessor.Get()
+	}
+	value = n.clamp(n.round(value + delta))
+	n.accessor.Set(value)
+	n.textinput.SetValue(n.format(value))
+	n.textinput.CursorEnd()
+}
+
+// clamp returns the value within the bounds.
+func (n *Number[T]) clamp(value T) T {
+	if n.minimum != nil {
+		value = max(value, *n.minimum)
+	}
+	if n.maximum != nil {
+		value = min(value, *n.maximum)
+	}
+	return value
+}
+
+// check checks the value against the bounds and the validation function.
+func (n *Number[T]) check(value T) error {
+	if n.minimum != nil && value < *n.minimum {
+		return fmt.Errorf("must be at least %s", n.format(*n.minimum))
+	}
+	if n.maximum != nil && value > *n.maximum {
+		return fmt.Errorf("must be at most %s", n.format(*n.maximum))
+	}
+	return n.validate(value)
+}
+
+// round rounds floats to the precision, if any.
+func (n *Number[T]) round(value T) T {
+	return roundNumber(value, n.precision)
+}
+
+// parse parses the text of the field, rounding floats to the precision. Blank
+// text is zero.
+func (n *Number[T]) parse(s string) (T, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	value, err := parseNumber[T](s)
+	if err != nil {
+		return value, errors.New("must be a number")
+	}
+	return n.round(value), nil
+}
+
+// format formats the value, with the precision for floats.
+func (n *Number[T]) format(value T) string {
+	return formatNumber(value, n.precision)
+}
+
+// isFloat returns whether T is a float.
+func isFloat[T int | int64 | float64]() bool {
+	var zero T
+	_, ok := any(zero).(float64)
+	return ok
+}
+
+// roundNumber rounds floats to the precision, if it isn't negative.
+func roundNumber[T int | int64 | float64](value T, precision int) T {
+	f, ok := any(value).(float64)
+	if !ok || precision < 0 {
+		return value
+	}
+	pow := math.Pow(10, float64(precision)) //nolint:mnd
+	return T(math.Round(f*pow) / pow)
+}
+
+// parseNumber parses an integer or a float, depending on T.
+func parseNumber[T int | int64 | float64](s string) (T, error) {
+	if isFloat[T]() {
+		f, err := strconv.ParseFloat(s, 64)
+		if err == nil && (math.IsInf(f, 0) || math.IsNaN(f)) {
+			err = strconv.ErrSyntax
+		}
+		return T(f), err //nolint:wrapcheck
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	return T(i), err //nolint:wrapcheck
+}
+
+// formatNumber formats an integer, or a float with the precision. A negative
+// precision formats floats with as few digits as needed.
+func formatNumber[T int | int64 | float64](value T, precision int) string {
+	switch v := any(value).(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', precision, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (n *Number[T]) activeStyles() *FieldStyles {
+	theme := n.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if n.focused {
+		return &theme.Theme(n.hasDarkBg).Focused
+	}
+	return &theme.Theme(n.hasDarkBg).Blurred
+}
+
+// View renders the number field.
+func (n *Number[T]) View() string {
+	styles := n.activeStyles()
+	maxWidth := n.width - styles.Base.GetHorizontalFrameSize()
+
+	st := n.textinput.Styles()
+	st.Cursor.Color = styles.TextInput.Cursor.GetForeground()
+	st.Focused.Prompt = styles.TextInput.Prompt
+	st.Focused.Text = styles.TextInput.Text
+	st.Focused.Placeholder = styles.TextInput.Placeholder
+	n.textinput.SetStyles(st)
+
+	var sb strings.Builder
+	if n.title.val != "" || n.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(n.title.val, maxWidth)))
+		if n.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		if !n.inline {
+			sb.WriteString("\n")
+		}
+	}
+	if n.description.val != "" || n.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(n.description.val, maxWidth)))
+		if !n.inline {
+			sb.WriteString("\n")
+		}
+	}
+	sb.WriteString(n.textinput.View())
+
+	return styles.Base.
+		Width(n.width).
+		Height(n.height).
+		Render(sb.String())
+}
+
+// Run runs the number field.
+func (n *Number[T]) Run() error {
+	return Run(n)
+}
+
+// RunAccessible runs the number field in accessible mode.
+func (n *Number[T]) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := n.activeStyles()
+	prompt := styles.Title.
+		PaddingRight(1).
+		Render(cmp.Or(n.title.val, "Number:"))
+	defaultValue := n.accessor.Get()
+	value := accessibility.PromptNumber(w, r, prompt, &defaultValue, n.parse, n.check)
+	n.accessor.Set(value)
+	n.textinput.SetValue(n.format(value))
+	return nil
+}
+
+// answer sets the value of the number field from an answers source.
+func (n *Number[T]) answer(value any) error {
+	v, err := n.parse(answerString(value))
+	if err != nil {
+		return fmt.Errorf("%v is not a number", value)
+	}
+	n.accessor.Set(v)
+	n.textinput.SetValue(n.format(v))
+	return n.check(n.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (n *Number[T]) review() (string, string) {
+	return n.title.val, n.format(n.accessor.Get())
+}
+
+// WithKeyMap sets the keymap on a number field.
+func (n *Number[T]) WithKeyMap(k *KeyMap) Field {
+	n.keymap = k.Number
+	return n
+}
+
+// WithTheme sets the theme of the number field.
+func (n *Number[T]) WithTheme(theme Theme) Field {
+	if n.theme != nil {
+		return n
+	}
+	n.theme = theme
+	return n
+}
+
+// WithWidth sets the width of the number field.
+func (n *Number[T]) WithWidth(width int) Field {
+	styles := n.activeStyles()
+	n.width = width
+	frameSize := styles.Base.GetHorizontalFrameSize()
+	promptWidth := lipgloss.Width(n.textinput.Styles().Focused.Prompt.Render(n.textinput.Prompt))
+	titleWidth := lipgloss.Width(styles.Title.Render(n.title.val))
+	descriptionWidth := lipgloss.Width(styles.Description.Render(n.description.val))
+	n.textinput.SetWidth(width - frameSize - promptWidth - 1)
+	if n.inline {
+		n.textinput.SetWidth(n.textinput.Width() - titleWidth - descriptionWidth)
+	}
+	return n
+}
+
+// WithHeight sets the height of the number field.
+func (n *Number[T]) WithHeight(height int) Field {
+	n.height = height
+	return n
+}
+
+// WithPosition sets the position of the number field.
+func (n *Number[T]) WithPosition(p FieldPosition) Field {
+	n.keymap.Prev.SetEnabled(!p.IsFirst())
+	n.keymap.Next.SetEnabled(!p.IsLast())
+	n.keymap.Submit.SetEnabled(p.IsLast())
+	return n
+}
+
+// GetKey returns the key of the field.
+func (n *Number[T]) GetKey() string { return n.key }
+
+// GetValue returns the value of the field.
+func (n *Number[T]) GetValue() any {
+	return n.accessor.Get()
+}
@@ -0,0 +1,782 @@
+package huh
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+)
+
+// PasswordStrength is the strength of a password.
+type PasswordStrength int
+
+// Password strengths, from the weakest.
+const (
+	PasswordVeryWeak PasswordStrength = iota
+	PasswordWeak
+	PasswordFair
+	PasswordStrong
+	PasswordVeryStrong
+)
+
+// String returns the name of the strength.
+func (s PasswordStrength) String() string {
+	switch {
+	case s <= PasswordVeryWeak:
+		return "very weak"
+	case s == PasswordWeak:
+		return "weak"
+	case s == PasswordFair:
+		return "fair"
+	case s == PasswordStrong:
+		return "strong"
+	default:
+		return "very strong"
+	}
+}
+
+// PasswordScorer scores the strength of a password.
+//
+// The password is given as bytes so that a secret value never has to go
+// through a string. They may be zeroed once the scorer returns, so it must not
+// keep them.
+type PasswordScorer func(password []byte) PasswordStrength
+
+// EntropyScorer scores a password by estimating its entropy, from its length
+// and the kinds of characters it uses. It's the default scorer of password
+// fields.
+func EntropyScorer(password []byte) PasswordStrength {
+	return entropyStrength(passwordEntropy(password))
+}
+
+// entropyStrength returns the strength of a password with the given bits of
+// entropy.
+func entropyStrength(bits float64) PasswordStrength {
+	switch {
+	case bits < 30: //nolint:mnd
+		return PasswordVeryWeak
+	case bits < 45: //nolint:mnd
+		return PasswordWeak
+	case bits < 60: //nolint:mnd
+		return PasswordFair
+	case bits < 80: //nolint:mnd
+		return PasswordStrong
+	default:
+		return PasswordVeryStrong
+	}
+}
+
+// passwordEntropy estimates the bits of entropy of a password, as its length
+// times the bits of the alphabet its characters come from. A character
+// repeating the previous one, or following it, doesn't count.
+func passwordEntropy(password []byte) float64 {
+	var lower, upper, digit, symbol, other bool
+	length := 0
+	prev := rune(-1)
+	for len(password) > 0 {
+		r, size := utf8.DecodeRune(password)
+		password = password[size:]
+		switch {
+		case r <= unicode.MaxASCII && unicode.IsLower(r):
+			lower = true
+		case r <= unicode.MaxASCII && unicode.IsUpper(r):
+			upper = true
+		case r <= unicode.MaxASCII && unicode.IsDigit(r):
+			digit = true
+		case r <= unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+		if r != prev && r != prev+1 {
+			length++
+		}
+		prev = r
+	}
+
+	var alphabet int
+	for _, kind := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if kind.used {
+			alphabet += kind.size
+		}
+	}
+	if alphabet == 0 {
+		return 0
+	}
+	return float64(length) * math.Log2(float64(alphabet))
+}
+
+// Password is a form field to choose a password.
+//
+// The password is typed twice, unless Confirm is turned off, and a meter shows
+// its strength while typing. It can be revealed with ctrl+r, unless it has a
+// secret value.
+type Password struct {
+	accessor Accessor[string]
+	secret   Accessor[[]byte]
+	key      string
+	hide     func() bool
+	id       int
+
+	// customization
+	title       Eval[string]
+	description Eval[string]
+	confirm     bool
+	scorer      PasswordScorer
+	minStrength PasswordStrength
+
+	// error handling
+	validate       func(string) error
+	validateSecret func([]byte) error
+	err            error
+
+	// state
+	entry              textinput.Model
+	confirmation       textinput.Model
+	secretEntry        secretBuffer
+	secretConfirmation secretBuffer
+	confirming         bool
+	revealed           bool
+	focused            bool
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    PasswordKeyMap
+}
+
+// NewPassword returns a new password field.
+func NewPassword() *Password {
+	entry := textinput.New()
+	entry.EchoMode = textinput.EchoPassword
+	confirmation := textinput.New()
+	confirmation.EchoMode = textinput.EchoPassword
+
+	return &Password{
+		accessor:     &EmbeddedAccessor[string]{},
+		id:           nextID(),
+		title:        Eval[string]{cache: make(map[uint64]string)},
+		description:  Eval[string]{cache: make(map[uint64]string)},
+		confirm:      true,
+		validate:     func(string) error { return nil },
+		entry:        entry,
+		confirmation: confirmation,
+	}
+}
+
+// Value sets the value of the password field.
+func (p *Password) Value(value *string) *Password {
+	return p.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the password field.
+func (p *Password) Accessor(accessor Accessor[string]) *Password {
+	p.accessor = accessor
+	p.entry.SetValue(p.accessor.Get())
+	p.confirmation.SetValue(p.accessor.Get())
+	return p
+}
+
+// SecretValue sets the value of the password field to a secret, held in bytes
+// rather than a string so it can be wiped with Zero once it's been used.
+func (p *Password) SecretValue(value *[]byte) *Password {
+	return p.SecretAccessor(NewPointerAccessor(value))
+}
+
+// SecretAccessor sets the accessor of the secret value of the password field.
+//
+// The password being typed is kept in buffers of the field, wiped when the
+// field is blurred, and set as a new slice on the accessor once confirmed. The
+// slice it replaces is zeroed. The value of Value or Accessor is left empty,
+// Validate doesn't apply, use ValidateSecret instead, and the password can't
+// be revealed.
+func (p *Password) SecretAccessor(accessor Accessor[[]byte]) *Password {
+	p.secret = accessor
+	return p
+}
+
+// ValidateSecret sets the validation function of the secret value of the
+// password field.
+func (p *Password) ValidateSecret(validate func([]byte) error) *Password {
+	p.validateSecret = validate
+	return p
+}
+
+// Key sets the key of the password field.
+func (p *Password) Key(key string) *Password {
+	p.key = key
+	return p
+}
+
+// Title sets the title of the password field.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (p *Password) Title(title string) *Password {
+	p.title.val = title
+	p.title.fn = nil
+	return p
+}
+
+// TitleFunc sets the title func of the password field.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (p *Password) TitleFunc(f func() string, bindings any) *Password {
+	p.title.fn = f
+	p.title.bindings = bindings
+	return p
+}
+
+// Description sets the description of the password field.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (p *Password) Description(description string) *Password {
+	p.description.val = description
+	p.description.fn = nil
+	return p
+}
+
+// DescriptionFunc sets the description func of the password field.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (p *Password) DescriptionFunc(f func() string, bindings any) *Password {
+	p.description.fn = f
+	p.description.bindings = bindings
+	return p
+}
+
+// Confirm sets whether the password has to be typed twice. Defaults to true.
+func (p *Password) Confirm(confirm bool) *Password {
+	p.confirm = confirm
+	return p
+}
+
+// Scorer sets the func scoring the strength of the password. Defaults to
+// EntropyScorer.
+func (p *Password) Scorer(scorer PasswordScorer) *Password {
+	p.scorer = scorer
+	return p
+}
+
+// MinStrength sets the minimum strength of the password.
+func (p *Password) MinStrength(strength PasswordStrength) *Password {
+	p.minStrength = strength
+	return p
+}
+
+// Validate sets the validation function of the password field.
+func (p *Password) Validate(validate func(string) error) *Password {
+	p.validate = validate
+	return p
+}
+
+// Error returns the error of the password field.
+func (p *Password) Error() error { return p.err }
+
+// Skip returns whether the password field should be skipped or should be
+// blocking.
+func (p *Password) Skip() bool { return p.hidden() }
+
+// Hide sets whether the password field is hidden.
+func (p *Password) Hide(hide bool) *Password {
+	return p.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the password field is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (p *Password) HideFunc(hideFunc func() bool) *Password {
+	p.hide = hideFunc
+	return p
+}
+
+// hidden returns whether the password field is hidden.
+func (p *Password) hidden() bool { return p.hide != nil && p.hide() }
+
+// Zoom returns whether the password field should be zoomed.
+func (*Password) Zoom() bool { return false }
+
+// Focus focuses the password field.
+func (p *Password) Focus() tea.Cmd {
+	p.focused = true
+	p.confirming = false
+	p.confirmation.Blur()
+	if p.secret != nil {
+		p.secretEntry.load(p.secret.Get())
+		p.secretConfirmation.load(p.secret.Get())
+		p.secretEntry.echo(&p.entry)
+		p.secretConfirmation.echo(&p.confirmation)
+	}
+	return p.entry.Focus()
+}
+
+// Blur blurs the password field.
+func (p *Password) Blur() tea.Cmd {
+	p.focused = false
+	p.setRevealed(false)
+	p.entry.Blur()
+	p.confirmation.Blur()
+	if p.secret != nil {
+		p.secretEntry.wipe()
+		p.secretConfirmation.wipe()
+		p.err = p.checkSecret(p.secret.Get())
+		return nil
+	}
+	p.err = p.check(p.accessor.Get())
+	return nil
+}
+
+// KeyBinds returns the help message for the password field.
+func (p *Password) KeyBinds() []key.Binding {
+	if p.secret != nil {
+		return []key.Binding{p.keymap.Prev, p.keymap.Submit, p.keymap.Next}
+	}
+	return []key.Binding{p.keymap.Reveal, p.keymap.Prev, p.keymap.Submit, p.keymap.Next}
+}
+
+// Init initializes the password field.
+func (p *Password) Init() tea.Cmd {
+	p.entry.Blur()
+	p.confirmation.Blur()
+	return nil
+}
+
+// Update updates the password field.
+func (p *Password) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		p.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		if ok, hash := p.title.shouldUpdate(); ok {
+			p.title.bindingsHash = hash
+			if !p.title.loadFromCache() {
+				p.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: p.id, title: p.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := p.description.shouldUpdate(); ok {
+			p.description.bindingsHash = hash
+			if !p.description.loadFromCache() {
+				p.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: p.id, description: p.description.fn(), hash: hash}
+				})
+			}
+		}
+		return p, tea.Batch(cmds...)
+	case updateTitleMsg:
+		if msg.id == p.id && msg.hash == p.title.bindingsHash {
+			p.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == p.id && msg.hash == p.description.bindingsHash {
+			p.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		p.err = nil
+
+		// going back to the password works on the first field too.
+		back := p.keymap.Prev
+		back.SetEnabled(true)
+
+		switch {
+		case p.secret == nil && key.Matches(msg, p.keymap.Reveal):
+			p.setRevealed(!p.revealed)
+			return p, nil
+		case p.confirming && key.Matches(msg, back):
+			p.confirming = false
+			p.confirmation.Blur()
+			return p, p.entry.Focus()
+		case key.Matches(msg, p.keymap.Prev):
+			return p, PrevField
+		case key.Matches(msg, p.keymap.Next, p.keymap.Submit):
+			if p.secret != nil {
+				return p, p.submitSecret()
+			}
+			value := p.entry.Value()
+			if !p.confirming {
+				if p.err = p.check(value); p.err != nil {
+					return p, nil
+				}
+				if p.confirm {
+					p.confirming = true
+					p.entry.Blur()
+					return p, p.confirmation.Focus()
+				}
+			} else if p.confirmation.Value() != value {
+				p.err = errors.New("passwords don't match")
+				p.confirmation.SetValue("")
+				return p, nil
+			}
+			p.accessor.Set(value)
+			return p, NextField
+		}
+
+		if p.secret != nil {
+			buffer, input := p.activeSecret()
+			buffer.update(input.KeyMap, msg, input.CharLimit)
+			buffer.echo(input)
+			return p, nil
+		}
+	case tea.PasteMsg:
+		// pasted secrets go to the buffer, never to the text input.
+		if p.secret != nil {
+			p.err = nil
+			buffer, input := p.activeSecret()
+			buffer.insert(msg.Content, input.CharLimit)
+			buffer.echo(input)
+			return p, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	if p.confirming {
+		p.confirmation, cmd = p.confirmation.Update(msg)
+	} else {
+		p.entry, cmd = p.entry.Update(msg)
+	}
+	cmds = append(cmds, cmd)
+	return p, tea.Batch(cmds...)
+}
+
+// setRevealed sets whether the password is shown as typed.
+func (p *Password) setRevealed(revealed bool) {
+	p.revealed = revealed
+	mode := textinput.EchoPassword
+	if revealed {
+		mode = textinput.EchoNormal
+	}
+	p.entry.EchoMode = mode
+	p.confirmation.EchoMode = mode
+}
+
+// activeSecret returns the buffer being typed in, and its text input.
+func (p *Password) activeSecret() (*secretBuffer, *textinput.Model) {
+	if p.confirming {
+		return &p.secretConfirmation, &p.confirmation
+	}
+	return &p.secretEntry, &p.entry
+}
+
+// submitSecret checks the secret being typed, then asks for its confirmation
+// and sets it on the accessor once confirmed, zeroing the one it replaces.
+func (p *Password) submitSecret() tea.Cmd {
+	if !p.confirming {
+		secret := p.secretEntry.bytes()
+		p.err = p.checkSecret(secret)
+		Zero(secret)
+		if p.err != nil {
+			return nil
+		}
+		if p.confirm {
+			p.confirming = true
+			p.entry.Blur()
+			return p.confirmation.Focus()
+		}
+	} else if !slices.Equal(p.secretConfirmation.runes, p.secretEntry.runes) {
+		p.err = errors.New("passwords don't match")
+		p.secretConfirmation.wipe()
+		p.secretConfirmation.echo(&p.confirmation)
+		return nil
+	}
+	old := p.secret.Get()
+	p.secret.Set(p.secretEntry.bytes())
+	Zero(old)
+	return NextField
+}
+
+// strength scores a password.
+func (p *Password) strength(password []byte) PasswordStrength {
+	if p.scorer == nil {
+		return EntropyScorer(password)
+	}
+	return p.scorer(password)
+}
+
+// checkStrength checks that a password is strong enough.
+func (p *Password) checkStrength(password []byte) error {
+	if p.strength(password) < p.minStrength {
+		return fmt.Errorf("too weak, must be at least %s", p.minStrength)
+	}
+	return nil
+}
+
+// check checks the strength of a password, then the validation function.
+func (p *Password) check(value string) error {
+	if err := p.checkStrength([]byte(value)); err != nil {
+		return err
+	}
+	return p.validate(value)
+}
+
+// checkSecret checks the strength of a secret, then the secret validation
+// function.
+func (p *Password) checkSecret(secret []byte) error {
+	if err := p.checkStrength(secret); err != nil {
+		return err
+	}
+	if p.validateSecret == nil {
+		return nil
+	}
+	return p.validateSecret(secret)
+}
+
+func (p *Password) activeStyles() *FieldStyles {
+	theme := p.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if p.focused {
+		return &theme.Theme(p.hasDarkBg).Focused
+	}
+	return &theme.Theme(p.hasDarkBg).Blurred
+}
+
+// meterView renders the strength of the password as a meter.
+func (p *Password) meterView() string {
+	styles := p.activeStyles()
+	var strength PasswordStrength
+	if p.secret != nil {
+		secret := p.secretEntry.bytes()
+		strength = p.strength(secret)
+		Zero(secret)
+	} else {
+		strength = p.strength([]byte(p.entry.Value()))
+	}
+	strength = min(max(strength, PasswordVeryWeak), PasswordVeryStrong)
+	style := styles.PasswordStrong
+	switch {
+	case strength <= PasswordWeak:
+		style = styles.PasswordWeak
+	case strength == PasswordFair:
+		style = styles.PasswordFair
+	}
+
+	segments := make([]string, PasswordVeryStrong+1)
+	for i := range segments {
+		if PasswordStrength(i) <= strength {
+			segments[i] = style.String()
+		} else {
+			segments[i] = styles.PasswordMeter.String()
+		}
+	}
+	return strings.Join(segments, " ") + " " + style.UnsetString().Render(strength.String())
+}
+
+// View renders the password field.
+func (p *Password) View() string {
+	styles := p.activeStyles()
+	maxWidth := p.width - styles.Base.GetHorizontalFrameSize()
+
+	for _, input := range []*textinput.Model{&p.entry, &p.confirmation} {
+		st := input.Styles()
+		st.Cursor.Color = styles.TextInput.Cursor.GetForeground()
+		st.Focused.Prompt = styles.TextInput.Prompt
+		st.Focused.Text = styles.TextInput.Text
+		st.Focused.Placeholder = styles.TextInput.Placeholder
+		input.SetStyles(st)
+	}
+
+	var sb strings.Builder
+	if p.title.val != "" || p.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(p.title.val, maxWidth)))
+		if p.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if p.description.val != "" || p.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(p.description.val, maxWidth)))
+		sb.WriteString("\n")
+	}
+	sb.WriteString(p.entry.View())
+	if p.focused && p.entry.Value() != "" {
+		sb.WriteString("\n" + p.meterView())
+	}
+	if p.confirming {
+		sb.WriteString("\n" + styles.Description.Render("Confirm") + "\n")
+		sb.WriteString(p.confirmation.View())
+	}
+
+	return styles.Base.
+		Width(p.width).
+		Height(p.height).
+		Render(sb.String())
+}
+
+// Run runs the password field.
+func (p *Password) Run() error {
+	return Run(p)
+}
+
+// RunAccessible runs the password field in accessible mode.
+//
+// The password is read without echo, so it needs a tty.
+func (p *Password) RunAccessible(w io.Writer, r io.Reader) error {
+	fd, ok := r.(interface{ Fd() uintptr })
+	if !ok {
+		return errors.New("password asking needs a tty")
+	}
+
+	styles := p.activeStyles()
+	prompt := styles.Title.
+		PaddingRight(1).
+		Render(cmp.Or(p.title.val, "Password:"))
+	if p.secret != nil {
+		return p.runSecretAccessible(w, fd.Fd(), prompt)
+	}
+	for {
+		value, err := accessibility.PromptPassword(w, fd.Fd(), prompt, p.check)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		_, _ = fmt.Fprintf(w, "Strength: %s\n", p.strength([]byte(value)))
+		if p.confirm {
+			confirmation, err := accessibility.PromptPassword(w, fd.Fd(), "Confirm: ", func(string) error { return nil })
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+			if confirmation != value {
+				_, _ = fmt.Fprintln(w, "passwords don't match")
+				_, _ = fmt.Fprintln(w)
+				continue
+			}
+		}
+		p.accessor.Set(value)
+		return nil
+	}
+}
+
+// runSecretAccessible asks for the secret value of the password field, and
+// its confirmation, without echo and without going through a string.
+func (p *Password) runSecretAccessible(w io.Writer, fd uintptr, prompt string) error {
+	for {
+		secret, err := accessibility.PromptSecret(w, fd, prompt, p.checkSecret)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		_, _ = fmt.Fprintf(w, "Strength: %s\n", p.strength(secret))
+		if p.confirm {
+			confirmation, err := accessibility.PromptSecret(w, fd, "Confirm: ", func([]byte) error { return nil })
+			if err != nil {
+				Zero(secret)
+				return err //nolint:wrapcheck
+			}
+			match := bytes.Equal(confirmation, secret)
+			Zero(confirmation)
+			if !match {
+				Zero(secret)
+				_, _ = fmt.Fprintln(w, "passwords don't match")
+				_, _ = fmt.Fprintln(w)
+				continue
+			}
+		}
+		old := p.secret.Get()
+		p.secret.Set(secret)
+		Zero(old)
+		return nil
+	}
+}
+
+// answer sets the value of the password field from an answers source.
+func (p *Password) answer(value any) error {
+	if p.secret != nil {
+		old := p.secret.Get()
+		p.secret.Set([]byte(answerString(value)))
+		Zero(old)
+		return p.checkSecret(p.secret.Get())
+	}
+	p.accessor.Set(answerString(value))
+	return p.check(p.accessor.Get())
+}
+
+// sensitive reports that the password is never written to disk.
+func (p *Password) sensitive() bool {
+	return true
+}
+
+// review returns the title and value shown on the form's review page. The
+// password is masked.
+func (p *Password) review() (string, string) {
+	n := utf8.RuneCountInString(p.accessor.Get())
+	if p.secret != nil {
+		n = utf8.RuneCount(p.secret.Get())
+	}
+	return p.title.val, strings.Repeat(string(p.entry.EchoCharacter), n)
+}
+
+// WithKeyMap sets the keymap on a password field.
+func (p *Password) WithKeyMap(k *KeyMap) Field {
+	p.keymap = k.Password
+	return p
+}
+
+// WithTheme sets the theme of the password field.
+func (p *Password) WithTheme(theme Theme) Field {
+	if p.theme != nil {
+		return p
+	}
+	p.theme = theme
+	return p
+}
+
+// WithWidth sets the width of the password field.
+func (p *Password) WithWidth(width int) Field {
+	p.width = width
+	return p
+}
+
+// WithHeight sets the height of the password field.
+func (p *Password) WithHeight(height int) Field {
+	p.height = height
+	return p
+}
+
+// WithPosition sets the position of the password field.
+func (p *Password) WithPosition(pos FieldPosition) Field {
+	p.keymap.Prev.SetEnabled(!pos.IsFirst())
+	p.keymap.Next.SetEnabled(!pos.IsLast())
+	p.keymap.Submit.SetEnabled(pos.IsLast())
+	return p
+}
+
+// GetKey returns the key of the field.
+func (p *Password) GetKey() string { return p.key }
+
+// GetValue returns the value of the field.
+//
+// The value of a password field with a secret value is its secret.
+func (p *Password) GetValue() any {
+	if p.secret != nil {
+		return p.secret.Get()
+	}
+	return p.accessor.Get()
+}
@@ -0,0 +1,544 @@
+package huh
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+// Rank is a form field to put options in order, such as priorities.
+//
+// An option is grabbed with space and moved with up and down, or moved
+// directly with K and J. The value is the ordered values of the options, or
+// only the first of them with Top.
+type Rank[T comparable] struct {
+	accessor Accessor[[]T]
+	key      string
+	hide     func() bool
+	id       int
+
+	// customization
+	title       Eval[string]
+	description Eval[string]
+	options     []Option[T]
+	top         int
+
+	// error handling
+	validate func([]T) error
+	err      error
+
+	// state
+	cursor  int
+	grabbed bool
+	focused bool
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    RankKeyMap
+}
+
+// NewRank returns a new rank field.
+func NewRank[T comparable]() *Rank[T] {
+	return &Rank[T]{
+		accessor:    &EmbeddedAccessor[[]T]{},
+		id:          nextID(),
+		title:       Eval[string]{cache: make(map[uint64]string)},
+		description: Eval[string]{cache: make(map[uint64]string)},
+		validate:    func([]T) error { return nil },
+	}
+}
+
+// Value sets the value of the rank field.
+func (r *Rank[T]) Value(value *[]T) *Rank[T] {
+	return r.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the rank field.
+//
+// Options holding the values of the accessor are put first, in that order.
+func (r *Rank[T]) Accessor(accessor Accessor[[]T]) *Rank[T] {
+	r.accessor = accessor
+	r.orderOptions()
+	return r
+}
+
+// Key sets the key of the rank field.
+func (r *Rank[T]) Key(key string) *Rank[T] {
+	r.key = key
+	return r
+}
+
+// Title sets the title of the rank field.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (r *Rank[T]) Title(title string) *Rank[T] {
+	r.title.val = title
+	r.title.fn = nil
+	return r
+}
+
+// TitleFunc sets the title func of the rank field.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (r *Rank[T]) TitleFunc(f func() string, bindings any) *Rank[T] {
+	r.title.fn = f
+	r.title.bindings = bindings
+	return r
+}
+
+// Description sets the description of the rank field.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (r *Rank[T]) Description(description string) *Rank[T] {
+	r.description.val = description
+	r.description.fn = nil
+	return r
+}
+
+// DescriptionFunc sets the description func of the rank field.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (r *Rank[T]) DescriptionFunc(f func() string, bindings any) *Rank[T] {
+	r.description.fn = f
+	r.description.bindings = bindings
+	return r
+}
+
+// Options sets the options to rank, in their initial order.
+func (r *Rank[T]) Options(options ...Option[T]) *Rank[T] {
+	r.options = slices.Clone(options)
+	r.orderOptions()
+	return r
+}
+
+// Top sets the number of options that are ranked. Only the values of the
+// first n options are kept. Zero ranks every option.
+func (r *Rank[T]) Top(n int) *Rank[T] {
+	r.top = n
+	r.updateValue()
+	return r
+}
+
+// Validate sets the validation function of the rank field.
+func (r *Rank[T]) Validate(validate func([]T) error) *Rank[T] {
+	r.validate = validate
+	return r
+}
+
+// Error returns the error of the rank field.
+func (r *Rank[T]) Error() error { return r.err }
+
+// Skip returns whether the rank field should be skipped or should be blocking.
+func (r *Rank[T]) Skip() bool { return r.hidden() }
+
+// Hide sets whether the rank field is hidden.
+func (r *Rank[T]) Hide(hide bool) *Rank[T] {
+	return r.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the rank field is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (r *Rank[T]) HideFunc(hideFunc func() bool) *Rank[T] {
+	r.hide = hideFunc
+	return r
+}
+
+// hidden returns whether the rank field is hidden.
+func (r *Rank[T]) hidden() bool { return r.hide != nil && r.hide() }
+
+// Zoom returns whether the rank field should be zoomed.
+func (*Rank[T]) Zoom() bool { return false }
+
+// Focus focuses the rank field.
+func (r *Rank[T]) Focus() tea.Cmd {
+	r.focused = true
+	return nil
+}
+
+// Blur blurs the rank field.
+func (r *Rank[T]) Blur() tea.Cmd {
+	r.focused = false
+	r.setGrabbed(false)
+	r.err = r.validate(r.accessor.Get())
+	return nil
+}
+
+// KeyBinds returns the help message for the rank field.
+func (r *Rank[T]) KeyBinds() []key.Binding {
+	return []key.Binding{
+		r.keymap.Grab, r.keymap.Drop, r.keymap.Up, r.keymap.Down,
+		r.keymap.MoveUp, r.keymap.MoveDown,
+		r.keymap.Prev, r.keymap.Submit, r.keymap.Next,
+	}
+}
+
+// Init initializes the rank field.
+func (r *Rank[T]) Init() tea.Cmd {
+	return nil
+}
+
+// Update updates the rank field.
+func (r *Rank[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		r.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		if ok, hash := r.title.shouldUpdate(); ok {
+			r.title.bindingsHash = hash
+			if !r.title.loadFromCache() {
+				r.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: r.id, title: r.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := r.description.shouldUpdate(); ok {
+			r.description.bindingsHash = hash
+			if !r.description.loadFromCache() {
+				r.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: r.id, description: r.description.fn(), hash: hash}
+				})
+			}
+		}
+	case updateTitleMsg:
+		if msg.id == r.id && msg.hash == r.title.bindingsHash {
+			r.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == r.id && msg.hash == r.description.bindingsHash {
+			r.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		r.err = nil
+		switch {
+		case key.Matches(msg, r.keymap.Grab):
+			r.setGrabbed(len(r.options) > 0)
+		case key.Matches(msg, r.keymap.Drop):
+			r.setGrabbed(false)
+		case key.Matches(msg, r.keymap.MoveUp):
+			r.move(-1)
+		case key.Matches(msg, r.keymap.MoveDown):
+			r.move(1)
+		case key.Matches(msg, r.keymap.Up):
+			if r.grabbed {
+				r.move(-1)
+			} else {
+				r.cursor = max(r.cursor-1, 0)
+			}
+		case key.Matches(msg, r.keymap.Down):
+			if r.grabbed {
+				r.move(1)
+			} else {
+				r.cursor = max(min(r.cursor+1, len(r.options)-1), 0)
+			}
+		case key.Matches(msg, r.keymap.Prev):
+			r.setGrabbed(false)
+			return r, PrevField
+		case key.Matches(msg, r.keymap.Next, r.keymap.Submit):
+			r.setGrabbed(false)
+			if r.err = r.validate(r.accessor.Get()); r.err != nil {
+				return r, nil
+			}
+			return r, NextField
+		}
+	}
+
+	return r, tea.Batch(cmds...)
+}
+
+// move moves the option under the cursor, and the cursor with it.
+func (r *Rank[T]) move(delta int) {
+	to := r.cursor + delta
+	if to < 0 || to >= len(r.options) {
+		return
+	}
+	r.options[r.cursor], r.options[to] = r.options[to], r.options[r.cursor]
+	r.cursor = to
+	r.updateValue()
+}
+
+// setGrabbed grabs or drops the option under the cursor.
+func (r *Rank[T]) setGrabbed(grabbed bool) {
+	r.grabbed = grabbed
+	r.keymap.Grab.SetEnabled(!grabbed)
+	r.keymap.Drop.SetEnabled(grabbed)
+}
+
+// orderOptions puts the options holding the values first, in their order,
+// then sets the value.
+func (r *Rank[T]) orderOptions() {
+	values := r.accessor.Get()
+	slices.SortStableFunc(r.options, func(a, b Option[T]) int {
+		i, j := slices.Index(values, a.Value), slices.Index(values, b.Value)
+		if i < 0 {
+			i = len(values)
+		}
+		if j < 0 {
+			j = len(values)
+		}
+		return cmp.Compare(i, j)
+	})
+	r.updateValue()
+}
+
+// ranked returns the number of ranked options.
+func (r *Rank[T]) ranked() int {
+	if r.top > 0 {
+		return min(r.top, len(r.options))
+	}
+	return len(r.options)
+}
+
+// values returns the values of the ranked options.
+func (r *Rank[T]) values(options []Option[T]) []T {
+	values := make([]T, r.ranked())
+	for i := range values {
+		values[i] = options[i].Value
+	}
+	return values
+}
+
+// updateValue sets the value from the ranked options.
+func (r *Rank[T]) updateValue() {
+	r.accessor.Set(r.values(r.options))
+}
+
+func (r *Rank[T]) activeStyles() *FieldStyles {
+	theme := r.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if r.focused {
+		return &theme.Theme(r.hasDarkBg).Focused
+	}
+	return &theme.Theme(r.hasDarkBg).Blurred
+}
+
+// View renders the rank field.
+func (r *Rank[T]) View() string {
+	styles := r.activeStyles()
+	maxWidth := r.width - styles.Base.GetHorizontalFrameSize()
+
+	var sb strings.Builder
+	if r.title.val != "" || r.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(r.title.val, maxWidth)))
+		if r.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if r.description.val != "" || r.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(r.description.val, maxWidth)))
+		sb.WriteString("\n")
+	}
+
+	selector := styles.SelectSelector.String()
+	indent := strings.Repeat(" ", lipgloss.Width(selector))
+	numberWidth := len(strconv.Itoa(r.ranked())) + len(". ")
+	for i, option := range r.options {
+		if i == r.ranked() {
+			sb.WriteString(indent + styles.Description.Render(fmt.Sprintf("── top %d ──", r.ranked())) + "\n")
+		}
+		number := strings.Repeat(" ", numberWidth)
+		if i < r.ranked() {
+			number = fmt.Sprintf("%*d. ", numberWidth-len(". "), i+1)
+		}
+		current := i == r.cursor && r.focused
+		switch {
+		case current && r.grabbed:
+			sb.WriteString(selector + styles.SelectedOption.Render(number+option.Key+" ↕"))
+		case current:
+			sb.WriteString(selector + styles.SelectedOption.Render(number+option.Key))
+		case i < r.ranked():
+			sb.WriteString(indent + styles.Option.Render(number+option.Key))
+		default:
+			sb.WriteString(indent + styles.UnselectedOption.Render(number+option.Key))
+		}
+		sb.WriteString("\n")
+	}
+
+	return styles.Base.
+		Width(r.width).
+		Height(r.height).
+		Render(strings.TrimSuffix(sb.String(), "\n"))
+}
+
+// Run runs the rank field.
+func (r *Rank[T]) Run() error {
+	return Run(r)
+}
+
+// parseOrder parses a comma separated list of option numbers. Options left out
+// keep their order after the listed ones.
+func (r *Rank[T]) parseOrder(s string) ([]Option[T], error) {
+	if strings.TrimSpace(s) == "" {
+		return slices.Clone(r.options), nil
+	}
+
+	var order []int
+	for field := range strings.SplitSeq(s, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", strings.TrimSpace(field))
+		}
+		if n < 1 || n > len(r.options) {
+			return nil, fmt.Errorf("%d is not between 1 and %d", n, len(r.options))
+		}
+		if slices.Contains(order, n-1) {
+			return nil, fmt.Errorf("%d is listed twice", n)
+		}
+		order = append(order, n-1)
+	}
+	if r.top > 0 && len(order) < r.ranked() {
+		return nil, fmt.Errorf("list %d options", r.ranked())
+	}
+
+	options := make([]Option[T], 0, len(r.options))
+	for _, i := range order {
+		options = append(options, r.options[i])
+	}
+	for i, option := range r.options {
+		if !slices.Contains(order, i) {
+			options = append(options, option)
+		}
+	}
+	return options, nil
+}
+
+// RunAccessible runs the rank field in accessible mode.
+//
+// The order is given as a comma separated list of option numbers.
+func (r *Rank[T]) RunAccessible(w io.Writer, rd io.Reader) error {
+	styles := r.activeStyles()
+	_, _ = fmt.Fprintln(w, styles.Title.
+		PaddingRight(1).
+		Render(cmp.Or(r.title.val, "Rank:")))
+
+	for i, option := range r.options {
+		_, _ = fmt.Fprintf(w, "%d. %s\n", i+1, option.Key)
+	}
+
+	prompt := "Enter the numbers in order, separated by commas: "
+	if r.top > 0 && r.top < len(r.options) {
+		prompt = fmt.Sprintf("Enter the top %d numbers in order, separated by commas: ", r.top)
+	}
+	for {
+		input := accessibility.PromptString(w, rd, prompt, "", func(s string) error {
+			_, err := r.parseOrder(s)
+			return err
+		})
+		options, err := r.parseOrder(input)
+		if err != nil {
+			return err
+		}
+		if err := r.validate(r.values(options)); err != nil {
+			_, _ = fmt.Fprintln(w, err.Error())
+			_, _ = fmt.Fprintln(w)
+			continue
+		}
+		r.options = options
+		r.updateValue()
+		return nil
+	}
+}
+
+// answer sets the value of the rank field from an answers source.
+//
+// The answer is the ordered list of option values, keys, or values formatted
+// as strings. Options left out keep their order after the listed ones.
+func (r *Rank[T]) answer(value any) error {
+	values := make([]T, 0)
+	for _, item := range answerList(value) {
+		option, err := answerOption(r.options, item)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(values, option.Value) {
+			return fmt.Errorf("%q is listed twice", option.Key)
+		}
+		values = append(values, option.Value)
+	}
+	if r.top > 0 && len(values) < r.ranked() {
+		return fmt.Errorf("list %d options", r.ranked())
+	}
+	r.accessor.Set(values)
+	r.orderOptions()
+	return r.validate(r.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (r *Rank[T]) review() (string, string) {
+	keys := make([]string, r.ranked())
+	for i := range keys {
+		keys[i] = r.options[i].Key
+	}
+	return r.title.val, strings.Join(keys, ", ")
+}
+
+// WithKeyMap sets the keymap on a rank field.
+func (r *Rank[T]) WithKeyMap(k *KeyMap) Field {
+	r.keymap = k.Rank
+	r.setGrabbed(r.grabbed)
+	return r
+}
+
+// WithTheme sets the theme of the rank field.
+func (r *Rank[T]) WithTheme(theme Theme) Field {
+	if r.theme != nil {
+		return r
+	}
+	r.theme = theme
+	return r
+}
+
+// WithWidth sets the width of the rank field.
+func (r *Rank[T]) WithWidth(width int) Field {
+	r.width = width
+	return r
+}
+
+// WithHeight sets the height of the rank field.
+func (r *Rank[T]) WithHeight(height int) Field {
+	r.height = height
+	return r
+}
+
+// WithPosition sets the position of the rank field.
+func (r *Rank[T]) WithPosition(p FieldPosition) Field {
+	r.keymap.Prev.SetEnabled(!p.IsFirst())
+	r.keymap.Next.SetEnabled(!p.IsLast())
+	r.keymap.Submit.SetEnabled(p.IsLast())
+	return r
+}
+
+// GetKey returns the key of the field.
+func (r *Rank[T]) GetKey() string { return r.key }
+
+// GetValue returns the value of the field.
+func (r *Rank[T]) GetValue() any {
+	return r.accessor.Get()
+}
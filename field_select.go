@@ -2,6 +2,7 @@ package huh
 
 import (
 	"cmp"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -32,6 +33,7 @@ type Select[T comparable] struct {
 	id       int
 	accessor Accessor[T]
 	key      string
+	hide     func() bool
 
 	viewport viewport.Model
 
@@ -42,6 +44,11 @@ type Select[T comparable] struct {
 
 	validate func(T) error
 	err      error
+	other    *otherInput
+
+	// validateOther is kept for Other, which may be called after
+	// ValidateOther.
+	validateOther func(string) error
 
 	selected  int
 	focused   bool
@@ -96,14 +103,27 @@ func (s *Select[T]) Accessor(accessor Accessor[T]) *Select[T] {
 }
 
 func (s *Select[T]) selectValue(value T) {
+	if s.selectOther(value) {
+		return
+	}
 	for i, o := range s.options.val {
-		if o.Value == value {
+		if !o.other && o.Value == value {
 			s.selected = i
 			break
 		}
 	}
 }
 
+// selectOther moves the cursor to the "Other…" option, with a value as its
+// text, if the value isn't among the options.
+func (s *Select[T]) selectOther(value T) bool {
+	if s.other == nil || isOption(s.options.val, value) || !s.other.setValue(value) {
+		return false
+	}
+	s.selected = len(s.options.val) - 1
+	return true
+}
+
 // Key sets the key of the select field which can be used to retrieve the value
 // after submission.
 func (s *Select[T]) Key(key string) *Select[T] {
@@ -177,8 +197,8 @@ func (s *Select[T]) Options(options ...Option[T]) *Select[T] {
 	if len(options) <= 0 {
 		return s
 	}
-	s.options.val = options
-	s.filteredOptions = options
+	s.options.val = withOther(options, s.other, false)
+	s.filteredOptions = s.options.val
 
 	s.selectOption()
 
@@ -189,9 +209,13 @@ func (s *Select[T]) Options(options ...Option[T]) *Select[T] {
 }
 
 func (s *Select[T]) selectOption() {
+	if s.selectOther(s.accessor.Get()) {
+		s.ensureCursorVisible()
+		return
+	}
 	// Set the cursor to the existing value or the last selected option.
 	for i, option := range s.options.val {
-		if option.Value == s.accessor.Get() {
+		if !option.other && option.Value == s.accessor.Get() {
 			s.selected = i
 			break
 		}
@@ -200,6 +224,7 @@ func (s *Select[T]) selectOption() {
 			break
 		}
 	}
+	s.selected = skipDisabled(s.options.val, s.selected, 1, false)
 	s.ensureCursorVisible()
 }
 
@@ -238,6 +263,37 @@ func (s *Select[T]) OptionsFunc(f func() []Option[T], bindings any) *Select[T] {
 	return s
 }
 
+// Other appends an "Other…" option, labeled as given, to enter an answer that
+// isn't among the options. Choosing it reveals an input for the answer, which
+// is validated by ValidateOther. A value that isn't among the options is shown
+// as the answer of this option.
+//
+// It only applies to Select[string], and is ignored otherwise.
+func (s *Select[T]) Other(label string) *Select[T] {
+	if !acceptsOther[T]() {
+		return s
+	}
+	s.other = newOtherInput(label, s.validateOther)
+	s.options.val = withOther(s.options.val, s.other, false)
+	s.filteredOptions = s.options.val
+	s.selectOption()
+	s.updateViewportSize()
+	s.updateValue()
+	return s
+}
+
+// ValidateOther sets the validation function of the answer of the "Other…"
+// option. Defaults to ValidateNotEmpty.
+//
+// It may be called before or after Other.
+func (s *Select[T]) ValidateOther(validate func(string) error) *Select[T] {
+	s.validateOther = validate
+	if s.other != nil {
+		s.other.validate = validate
+	}
+	return s
+}
+
 // Inline sets whether the select input should be inline.
 func (s *Select[T]) Inline(v bool) *Select[T] {
 	s.inline = v
@@ -269,7 +325,24 @@ func (s *Select[T]) Validate(validate func(T) error) *Select[T] {
 func (s *Select[T]) Error() error { return s.err }
 
 // Skip returns whether the select should be skipped or should be blocking.
-func (*Select[T]) Skip() bool { return false }
+func (s *Select[T]) Skip() bool { return s.hidden() }
+
+// Hide sets whether the select is hidden.
+func (s *Select[T]) Hide(hide bool) *Select[T] {
+	return s.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the select is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (s *Select[T]) HideFunc(hideFunc func() bool) *Select[T] {
+	s.hide = hideFunc
+	return s
+}
+
+// hidden returns whether the select is hidden.
+func (s *Select[T]) hidden() bool { return s.hide != nil && s.hide() }
 
 // Zoom returns whether the input should be zoomed.
 func (*Select[T]) Zoom() bool { return false }
@@ -287,8 +360,11 @@ func (s *Select[T]) Blur() tea.Cmd {
 		s.clearFilter()
 		s.selectValue(value)
 	}
+	if s.other != nil {
+		s.other.stopEditing()
+	}
 	s.focused = false
-	s.err = s.validate(value)
+	s.err = s.check(value)
 	return nil
 }
 
@@ -300,11 +376,23 @@ func (s *Select[T]) Hovered() (T, bool) {
 		var zero T
 		return zero, false
 	}
-	return s.filteredOptions[s.selected].Value, true
+	return s.optionValue(s.filteredOptions[s.selected]), true
+}
+
+// HoveredKey returns the key, the displayed label, of the option under the
+// cursor, and a bool indicating whether one was found.
+func (s *Select[T]) HoveredKey() (string, bool) {
+	if len(s.filteredOptions) == 0 || s.selected >= len(s.filteredOptions) {
+		return "", false
+	}
+	return s.filteredOptions[s.selected].Key, true
 }
 
 // KeyBinds returns the help keybindings for the select field.
 func (s *Select[T]) KeyBinds() []key.Binding {
+	if s.other != nil && s.other.editing {
+		return []key.Binding{s.keymap.Prev, s.keymap.Next, s.keymap.Submit}
+	}
 	return []key.Binding{
 		s.keymap.Up,
 		s.keymap.Down,
@@ -333,6 +421,10 @@ func (s *Select[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 	if s.filtering {
 		s.filter, cmd = s.filter.Update(msg)
 	}
+	if _, ok := msg.(tea.KeyPressMsg); !ok && s.other != nil && s.other.editing {
+		s.other.input, cmd = s.other.input.Update(msg)
+		s.updateValue()
+	}
 
 	switch msg := msg.(type) {
 	case tea.BackgroundColorMsg:
@@ -361,6 +453,7 @@ func (s *Select[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 			s.clearFilter()
 			s.options.bindingsHash = hash
 			if s.options.loadFromCache() {
+				s.options.val = withOther(s.options.val, s.other, false)
 				s.filteredOptions = s.options.val
 				s.selected = ordered.Clamp(s.selected, 0, len(s.options.val)-1)
 			} else {
@@ -391,16 +484,21 @@ func (s *Select[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 	case updateOptionsMsg[T]:
 		if msg.id == s.id && msg.hash == s.options.bindingsHash {
 			s.options.update(msg.options)
+			s.options.val = withOther(s.options.val, s.other, false)
 			s.selectOption()
 
 			// since we're updating the options, we need to update the selected
 			// cursor position and filteredOptions.
-			s.selected = ordered.Clamp(s.selected, 0, len(msg.options)-1)
-			s.filteredOptions = msg.options
+			s.selected = ordered.Clamp(s.selected, 0, len(s.options.val)-1)
+			s.selected = skipDisabled(s.options.val, s.selected, 1, false)
+			s.filteredOptions = s.options.val
 			s.updateValue()
 		}
 	case tea.KeyPressMsg:
 		s.err = nil
+		if s.other != nil && s.other.editing {
+			return s.updateOther(msg)
+		}
 		switch {
 		case key.Matches(msg, s.keymap.Filter):
 			s.setFiltering(true)
@@ -427,29 +525,33 @@ func (s *Select[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 			if s.selected < 0 {
 				s.selected = len(s.filteredOptions) - 1
 				s.viewport.GotoBottom()
-			} else {
-				s.ensureCursorVisible()
 			}
+			s.selected = skipDisabled(s.filteredOptions, s.selected, -1, true)
+			s.ensureCursorVisible()
 			s.updateValue()
 		case key.Matches(msg, s.keymap.GotoTop):
 			if s.filtering {
 				break
 			}
-			s.selected = 0
+			s.selected = skipDisabled(s.filteredOptions, 0, 1, false)
 			s.viewport.GotoTop()
+			s.ensureCursorVisible()
 			s.updateValue()
 		case key.Matches(msg, s.keymap.GotoBottom):
 			if s.filtering {
 				break
 			}
-			s.selected = len(s.filteredOptions) - 1
+			s.selected = skipDisabled(s.filteredOptions, len(s.filteredOptions)-1, -1, false)
 			s.viewport.GotoBottom()
+			s.ensureCursorVisible()
 		case key.Matches(msg, s.keymap.HalfPageUp):
 			s.selected = max(s.selected-s.viewport.Height()/2, 0)
+			s.selected = skipDisabled(s.filteredOptions, s.selected, -1, false)
 			s.ensureCursorVisible()
 			s.updateValue()
 		case key.Matches(msg, s.keymap.HalfPageDown):
 			s.selected = min(s.selected+s.viewport.Height()/2, len(s.filteredOptions)-1)
+			s.selected = skipDisabled(s.filteredOptions, s.selected, 1, false)
 			s.ensureCursorVisible()
 			s.updateValue()
 		case key.Matches(msg, s.keymap.Down, s.keymap.Right):
@@ -463,16 +565,16 @@ func (s *Select[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 			if s.selected > len(s.filteredOptions)-1 {
 				s.selected = 0
 				s.viewport.GotoTop()
-			} else {
-				s.ensureCursorVisible()
 			}
+			s.selected = skipDisabled(s.filteredOptions, s.selected, 1, true)
+			s.ensureCursorVisible()
 			s.updateValue()
 		case key.Matches(msg, s.keymap.Prev):
 			if s.selected >= len(s.filteredOptions) {
 				break
 			}
 			s.updateValue()
-			s.err = s.validate(s.accessor.Get())
+			s.err = s.check(s.accessor.Get())
 			if s.err != nil {
 				return s, nil
 			}
@@ -483,8 +585,15 @@ func (s *Select[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 				break
 			}
 			s.setFiltering(false)
+			if option := s.filteredOptions[s.selected]; option.disabled {
+				s.err = option.disabledError()
+				return s, nil
+			}
+			if s.filteredOptions[s.selected].other {
+				return s, s.other.edit()
+			}
 			s.updateValue()
-			s.err = s.validate(s.accessor.Get())
+			s.err = s.check(s.accessor.Get())
 			if s.err != nil {
 				return s, nil
 			}
@@ -502,6 +611,51 @@ func (s *Select[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return s, cmd
 }
 
+// updateOther updates the answer of the "Other…" option being edited.
+func (s *Select[T]) updateOther(msg tea.KeyPressMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, s.keymap.Next, s.keymap.Submit):
+		s.updateValue()
+		if s.err = s.check(s.accessor.Get()); s.err != nil {
+			return s, nil
+		}
+		s.other.stopEditing()
+		return s, NextField
+	case key.Matches(msg, s.keymap.Prev):
+		s.other.stopEditing()
+		return s, nil
+	case s.other.leaves(msg, s.keymap.Up, s.keymap.Down, s.keymap.Left, s.keymap.Right):
+		s.other.stopEditing()
+		return s.Update(msg)
+	}
+
+	var cmd tea.Cmd
+	s.other.input, cmd = s.other.input.Update(msg)
+	s.updateValue()
+	return s, cmd
+}
+
+// optionValue returns the value of an option, which is its answer for the
+// "Other…" one.
+func (s *Select[T]) optionValue(option Option[T]) T {
+	if option.other {
+		return otherValue[T](s.other)
+	}
+	return option.Value
+}
+
+// check checks the answer of the "Other…" option if it's the value, then
+// the validation function.
+func (s *Select[T]) check(value T) error {
+	if s.other != nil && !isOption(s.options.val, value) {
+		text, _ := any(value).(string)
+		if err := s.other.validate(text); err != nil {
+			return err
+		}
+	}
+	return s.validate(value)
+}
+
 func (s *Select[T]) updateFilteredOptions(previousFilter string) {
 	s.filteredOptions = s.options.val
 	if s.filter.Value() != "" {
@@ -518,16 +672,16 @@ func (s *Select[T]) updateFilteredOptions(previousFilter string) {
 	if s.filter.Value() != previousFilter {
 		// ensureCursorVisible only scrolls the minimum needed, so the offset
 		// has to be reset too or earlier matches stay hidden above the window.
-		s.selected = 0
+		s.selected = skipDisabled(s.filteredOptions, 0, 1, false)
 		s.viewport.GotoTop()
 		return
 	}
-	s.selected = min(s.selected, len(s.filteredOptions)-1)
+	s.selected = skipDisabled(s.filteredOptions, min(s.selected, len(s.filteredOptions)-1), 1, false)
 }
 
 func (s *Select[T]) updateValue() {
-	if s.selected < len(s.filteredOptions) && s.selected >= 0 {
-		s.accessor.Set(s.filteredOptions[s.selected].Value)
+	if s.selected < len(s.filteredOptions) && s.selected >= 0 && !s.filteredOptions[s.selected].disabled {
+		s.accessor.Set(s.optionValue(s.filteredOptions[s.selected]))
 	}
 }
 
@@ -610,7 +764,8 @@ func (s *Select[T]) optionsView() (string, int, int) {
 	if s.inline {
 		option := styles.TextInput.Placeholder.Render("No matches")
 		if len(s.filteredOptions) > 0 {
-			option = styles.SelectedOption.Render(s.filteredOptions[s.selected].Key)
+			selected := s.filteredOptions[s.selected]
+			option = styles.SelectedOption.Render(s.optionKey(selected)) + optionSuffix(selected, styles)
 		}
 		return lipgloss.NewStyle().
 				Width(s.width).
@@ -694,21 +849,44 @@ func (s *Select[T]) renderOption(option Option[T], selected bool) string {
 	)
 
 	key := wrap(option.Key, maxWidth)
+	if option.other {
+		key = s.optionKey(option)
+	}
+	suffix := optionSuffix(option, styles)
 
-	if selected {
+	switch {
+	case selected:
 		return lipgloss.JoinHorizontal(
 			lipgloss.Left,
 			cursor,
 			styles.SelectedOption.Render(key),
+			suffix,
+		)
+	case option.disabled:
+		return lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			strings.Repeat(" ", cursorW),
+			styles.DisabledOption.Render(key),
+			suffix,
 		)
 	}
 	return lipgloss.JoinHorizontal(
 		lipgloss.Left,
 		strings.Repeat(" ", cursorW),
 		styles.UnselectedOption.Render(key),
+		suffix,
 	)
 }
 
+// optionKey returns the key of an option, followed by its answer for the
+// "Other…" one.
+func (s *Select[T]) optionKey(option Option[T]) string {
+	if option.other {
+		return s.other.view(s.activeStyles())
+	}
+	return option.Key
+}
+
 // View renders the select field.
 func (s *Select[T]) View() string {
 	styles := s.activeStyles()
@@ -764,15 +942,24 @@ func (s *Select[T]) RunAccessible(w io.Writer, r io.Reader) error {
 		Render(cmp.Or(s.title.val, "Select:")))
 
 	for i, option := range s.options.val {
-		_, _ = fmt.Fprintf(w, "%d. %s\n", i+1, option.Key)
+		if option.other {
+			_, _ = fmt.Fprintf(w, "%d. %s\n", i+1, s.optionKey(option))
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%d. %s\n", i+1, option.accessibleString())
 	}
 
 	var defaultValue *int
 	switch s.accessor.(type) {
 	case *PointerAccessor[T]: // if its of this type, it means it has a default value
 		s.selectOption() // make sure s.selected is set
-		idx := s.selected + 1
-		defaultValue = &idx
+		if s.selected < len(s.options.val) && !s.options.val[s.selected].disabled {
+			idx := s.selected + 1
+			defaultValue = &idx
+		}
+	}
+	if len(s.options.val) == 0 {
+		return errors.New("no options to select from")
 	}
 	prompt := fmt.Sprintf("Enter a number between %d and %d: ", 1, len(s.options.val))
 	if len(s.options.val) == 1 {
@@ -781,16 +968,60 @@ func (s *Select[T]) RunAccessible(w io.Writer, r io.Reader) error {
 	for {
 		choice := accessibility.PromptInt(w, r, prompt, 1, len(s.options.val), defaultValue)
 		option := s.options.val[choice-1]
-		if err := s.validate(option.Value); err != nil {
+		if option.disabled {
+			_, _ = fmt.Fprintln(w, option.disabledError())
+			_, _ = fmt.Fprintln(w)
+			continue
+		}
+		if option.other {
+			text := accessibility.PromptString(w, r, s.other.label+" ", s.other.input.Value(), s.other.validate)
+			s.other.input.SetValue(text)
+		}
+		if err := s.check(s.optionValue(option)); err != nil {
 			_, _ = fmt.Fprintln(w, err.Error())
 			_, _ = fmt.Fprintln(w)
 			continue
 		}
-		s.accessor.Set(option.Value)
+		s.accessor.Set(s.optionValue(option))
 		return nil
 	}
 }
 
+// answer sets the value of the select field from an answers source.
+//
+// The answer can be an option's value, its key, or its value formatted as a
+// string.
+func (s *Select[T]) answer(value any) error {
+	options := withoutOther(s.options.val)
+	if s.options.fn != nil {
+		options = s.options.fn()
+	}
+	option, err := answerOption(options, value)
+	if err != nil {
+		if s.other == nil || !s.other.setValue(answerString(value)) {
+			return err
+		}
+		option.Value = otherValue[T](s.other)
+	}
+	if option.disabled {
+		return option.disabledError()
+	}
+	s.accessor.Set(option.Value)
+	s.selectValue(option.Value)
+	return s.check(option.Value)
+}
+
+// review returns the title and value shown on the form's review page.
+func (s *Select[T]) review() (string, string) {
+	value := s.accessor.Get()
+	for _, option := range s.options.val {
+		if !option.other && option.Value == value {
+			return s.title.val, option.Key
+		}
+	}
+	return s.title.val, fmt.Sprint(value)
+}
+
 // WithTheme sets the theme of the select field.
 func (s *Select[T]) WithTheme(theme Theme) Field {
 	if s.theme != nil {
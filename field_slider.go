@@ -0,0 +1,485 @@
+package huh
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+const defaultSliderWidth = 40
+
+// Slider is a form field to pick a number within a range, on a horizontal
+// track.
+type Slider[T int | int64 | float64] struct {
+	accessor Accessor[T]
+	key      string
+	hide     func() bool
+	id       int
+
+	// customization
+	title       Eval[string]
+	description Eval[string]
+	ticks       map[T]string
+
+	// range
+	minimum T
+	maximum T
+	step    T
+	bigStep T
+
+	// error handling
+	validate func(T) error
+	err      error
+
+	// state
+	focused bool
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    SliderKeyMap
+}
+
+// NewSlider returns a new slider field, from 0 to 100 by default.
+func NewSlider[T int | int64 | float64]() *Slider[T] {
+	return &Slider[T]{
+		accessor:    &EmbeddedAccessor[T]{},
+		id:          nextID(),
+		title:       Eval[string]{cache: make(map[uint64]string)},
+		description: Eval[string]{cache: make(map[uint64]string)},
+		maximum:     100, //nolint:mnd
+		step:        1,
+		bigStep:     10, //nolint:mnd
+		validate:    func(T) error { return nil },
+	}
+}
+
+// Value sets the value of the slider.
+func (s *Slider[T]) Value(value *T) *Slider[T] {
+	return s.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the slider. A value out of the range is
+// clamped into it once the slider is focused.
+func (s *Slider[T]) Accessor(accessor Accessor[T]) *Slider[T] {
+	s.accessor = accessor
+	return s
+}
+
+// Key sets the key of the slider.
+func (s *Slider[T]) Key(key string) *Slider[T] {
+	s.key = key
+	return s
+}
+
+// Title sets the title of the slider.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (s *Slider[T]) Title(title string) *Slider[T] {
+	s.title.val = title
+	s.title.fn = nil
+	return s
+}
+
+// TitleFunc sets the title func of the slider.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (s *Slider[T]) TitleFunc(f func() string, bindings any) *Slider[T] {
+	s.title.fn = f
+	s.title.bindings = bindings
+	return s
+}
+
+// Description sets the description of the slider.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (s *Slider[T]) Description(description string) *Slider[T] {
+	s.description.val = description
+	s.description.fn = nil
+	return s
+}
+
+// DescriptionFunc sets the description func of the slider.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (s *Slider[T]) DescriptionFunc(f func() string, bindings any) *Slider[T] {
+	s.description.fn = f
+	s.description.bindings = bindings
+	return s
+}
+
+// Range sets the smallest and the largest values of the slider. A value out
+// of the range is clamped into it once the slider is focused.
+func (s *Slider[T]) Range(minimum, maximum T) *Slider[T] {
+	s.minimum, s.maximum = minimum, maximum
+	return s
+}
+
+// Step sets how much the left and right keys change the value. Defaults to 1.
+func (s *Slider[T]) Step(step T) *Slider[T] {
+	s.step = step
+	return s
+}
+
+// BigStep sets how much the left and right keys change the value with shift
+// held. Defaults to 10.
+func (s *Slider[T]) BigStep(step T) *Slider[T] {
+	s.bigStep = step
+	return s
+}
+
+// Ticks sets the labels shown under the track, by value. Labels that would
+// overlap the previous one are left out.
+func (s *Slider[T]) Ticks(ticks map[T]string) *Slider[T] {
+	s.ticks = ticks
+	return s
+}
+
+// Validate sets the validation function of the slider.
+func (s *Slider[T]) Validate(validate func(T) error) *Slider[T] {
+	s.validate = validate
+	return s
+}
+
+// Error returns the error of the slider.
+func (s *Slider[T]) Error() error { return s.err }
+
+// Skip returns whether the slider should be skipped or should be blocking.
+func (s *Slider[T]) Skip() bool { return s.hidden() }
+
+// Hide sets whether the slider is hidden.
+func (s *Slider[T]) Hide(hide bool) *Slider[T] {
+	return s.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the slider is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (s *Slider[T]) HideFunc(hideFunc func() bool) *Slider[T] {
+	s.hide = hideFunc
+	return s
+}
+
+// hidden returns whether the slider is hidden.
+func (s *Slider[T]) hidden() bool { return s.hide != nil && s.hide() }
+
+// Zoom returns whether the slider should be zoomed.
+func (*Slider[T]) Zoom() bool { return false }
+
+// Focus focuses the slider.
+func (s *Slider[T]) Focus() tea.Cmd {
+	s.focused = true
+	s.clamp()
+	return nil
+}
+
+// Blur blurs the slider.
+func (s *Slider[T]) Blur() tea.Cmd {
+	s.focused = false
+	s.err = s.check(s.accessor.Get())
+	return nil
+}
+
+// KeyBinds returns the help message for the slider.
+func (s *Slider[T]) KeyBinds() []key.Binding {
+	return []key.Binding{
+		s.keymap.Decrement, s.keymap.Increment, s.keymap.BigDecrement, s.keymap.BigIncrement,
+		s.keymap.Prev, s.keymap.Submit, s.keymap.Next,
+	}
+}
+
+// Init initializes the slider.
+func (s *Slider[T]) Init() tea.Cmd {
+	return nil
+}
+
+// Update updates the slider.
+func (s *Slider[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		s.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		if ok, hash := s.title.shouldUpdate(); ok {
+			s.title.bindingsHash = hash
+			if !s.title.loadFromCache() {
+				s.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: s.id, title: s.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := s.description.shouldUpdate(); ok {
+			s.description.bindingsHash = hash
+			if !s.description.loadFromCache() {
+				s.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: s.id, description: s.description.fn(), hash: hash}
+				})
+			}
+		}
+	case updateTitleMsg:
+		if msg.id == s.id && msg.hash == s.title.bindingsHash {
+			s.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == s.id && msg.hash == s.description.bindingsHash {
+			s.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		s.err = nil
+		switch {
+		case key.Matches(msg, s.keymap.Prev):
+			cmds = append(cmds, PrevField)
+		case key.Matches(msg, s.keymap.Next, s.keymap.Submit):
+			if s.err = s.check(s.accessor.Get()); s.err != nil {
+				break
+			}
+			cmds = append(cmds, NextField)
+		case key.Matches(msg, s.keymap.Decrement):
+			s.stepBy(-s.step)
+		case key.Matches(msg, s.keymap.Increment):
+			s.stepBy(s.step)
+		case key.Matches(msg, s.keymap.BigDecrement):
+			s.stepBy(-s.bigStep)
+		case key.Matches(msg, s.keymap.BigIncrement):
+			s.stepBy(s.bigStep)
+		}
+	}
+
+	return s, tea.Batch(cmds...)
+}
+
+// stepBy changes the value by delta, within the range.
+func (s *Slider[T]) stepBy(delta T) {
+	value := roundNumber(s.accessor.Get()+delta, s.precision())
+	s.accessor.Set(min(max(value, s.minimum), s.maximum))
+}
+
+// value returns the value of the slider, clamped into the range. The
+// builders don't clamp it, as the range may be set after the value.
+func (s *Slider[T]) value() T {
+	return min(max(s.accessor.Get(), s.minimum), s.maximum)
+}
+
+// clamp moves the value into the range, if it's out of it.
+func (s *Slider[T]) clamp() {
+	if value := s.value(); value != s.accessor.Get() {
+		s.accessor.Set(value)
+	}
+}
+
+// precision returns the number of decimals of the step, so that stepping
+// floats doesn't pile up rounding errors.
+func (s *Slider[T]) precision() int {
+	if !isFloat[T]() {
+		return 0
+	}
+	_, decimals, _ := strings.Cut(formatNumber(s.step, -1), ".")
+	return len(decimals)
+}
+
+func (s *Slider[T]) format(value T) string {
+	return formatNumber(value, s.precision())
+}
+
+// check checks the value against the range and the validation function.
+func (s *Slider[T]) check(value T) error {
+	if value < s.minimum || value > s.maximum {
+		return fmt.Errorf("must be between %s and %s", s.format(s.minimum), s.format(s.maximum))
+	}
+	return s.validate(value)
+}
+
+// position returns the cell of the track the value falls on.
+func (s *Slider[T]) position(value T, width int) int {
+	if s.maximum <= s.minimum || width <= 1 {
+		return 0
+	}
+	ratio := float64(value-s.minimum) / float64(s.maximum-s.minimum)
+	return int(math.Round(min(max(ratio, 0), 1) * float64(width-1)))
+}
+
+func (s *Slider[T]) activeStyles() *FieldStyles {
+	theme := s.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if s.focused {
+		return &theme.Theme(s.hasDarkBg).Focused
+	}
+	return &theme.Theme(s.hasDarkBg).Blurred
+}
+
+// View renders the slider.
+func (s *Slider[T]) View() string {
+	styles := s.activeStyles()
+	maxWidth := s.width - styles.Base.GetHorizontalFrameSize()
+
+	var sb strings.Builder
+	if s.title.val != "" || s.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(s.title.val, maxWidth)))
+		if s.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if s.description.val != "" || s.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(s.description.val, maxWidth)))
+		sb.WriteString("\n")
+	}
+
+	value := s.value()
+	label := s.format(value)
+	labelWidth := max(lipgloss.Width(s.format(s.minimum)), lipgloss.Width(s.format(s.maximum)))
+	width := defaultSliderWidth
+	if available := maxWidth - labelWidth - 1; available > 0 {
+		width = min(width, available)
+	}
+	pos := s.position(value, width)
+
+	thumb := lipgloss.Width(styles.SliderThumb.Value())
+	sb.WriteString(styles.SliderFilled.UnsetString().Render(strings.Repeat(styles.SliderFilled.Value(), pos)))
+	sb.WriteString(styles.SliderThumb.String())
+	sb.WriteString(styles.SliderTrack.UnsetString().Render(strings.Repeat(styles.SliderTrack.Value(), max(width-pos-thumb, 0))))
+	sb.WriteString(" ")
+	sb.WriteString(styles.Option.Render(label))
+
+	if len(s.ticks) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(styles.Description.Render(s.tickLine(width)))
+	}
+
+	return styles.Base.
+		Width(s.width).
+		Height(s.height).
+		Render(sb.String())
+}
+
+// tickLine lays out the tick labels under a track of the given width.
+func (s *Slider[T]) tickLine(width int) string {
+	values := make([]T, 0, len(s.ticks))
+	for value := range s.ticks {
+		values = append(values, value)
+	}
+	slices.Sort(values)
+
+	var sb strings.Builder
+	end := 0
+	for _, value := range values {
+		label := s.ticks[value]
+		labelWidth := lipgloss.Width(label)
+		// labels are centered on their tick, and kept on the track.
+		start := min(max(s.position(value, width)-labelWidth/2, 0), max(width-labelWidth, 0)) //nolint:mnd
+		if end > 0 && start <= end {
+			continue
+		}
+		sb.WriteString(strings.Repeat(" ", start-end))
+		sb.WriteString(label)
+		end = start + labelWidth
+	}
+	return sb.String()
+}
+
+// Run runs the slider field.
+func (s *Slider[T]) Run() error {
+	return Run(s)
+}
+
+// RunAccessible runs the slider field in accessible mode.
+func (s *Slider[T]) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := s.activeStyles()
+	prompt := styles.Title.
+		PaddingRight(1).
+		Render(cmp.Or(s.title.val, "Value"), "("+s.format(s.minimum)+"-"+s.format(s.maximum)+"):")
+	s.clamp()
+	defaultValue := s.accessor.Get()
+	parse := func(str string) (T, error) {
+		value, err := parseNumber[T](strings.TrimSpace(str))
+		if err != nil {
+			return value, errors.New("must be a number")
+		}
+		return value, nil
+	}
+	s.accessor.Set(accessibility.PromptNumber(w, r, prompt, &defaultValue, parse, s.check))
+	return nil
+}
+
+// answer sets the value of the slider from an answers source.
+func (s *Slider[T]) answer(value any) error {
+	v, err := parseNumber[T](strings.TrimSpace(answerString(value)))
+	if err != nil {
+		return fmt.Errorf("%v is not a number", value)
+	}
+	s.accessor.Set(v)
+	return s.check(s.accessor.Get())
+}
+
+// review returns the title and value shown on the form's review page.
+func (s *Slider[T]) review() (string, string) {
+	return s.title.val, s.format(s.value())
+}
+
+// WithKeyMap sets the keymap on a slider.
+func (s *Slider[T]) WithKeyMap(k *KeyMap) Field {
+	s.keymap = k.Slider
+	return s
+}
+
+// WithTheme sets the theme of the slider.
+func (s *Slider[T]) WithTheme(theme Theme) Field {
+	if s.theme != nil {
+		return s
+	}
+	s.theme = theme
+	return s
+}
+
+// WithWidth sets the width of the slider.
+func (s *Slider[T]) WithWidth(width int) Field {
+	s.width = width
+	return s
+}
+
+// WithHeight sets the height of the slider.
+func (s *Slider[T]) WithHeight(height int) Field {
+	s.height = height
+	return s
+}
+
+// WithPosition sets the position of the slider.
+func (s *Slider[T]) WithPosition(p FieldPosition) Field {
+	s.keymap.Prev.SetEnabled(!p.IsFirst())
+	s.keymap.Next.SetEnabled(!p.IsLast())
+	s.keymap.Submit.SetEnabled(p.IsLast())
+	return s
+}
+
+// GetKey returns the key of the field.
+func (s *Slider[T]) GetKey() string { return s.key }
+
+// GetValue returns the value of the field.
+func (s *Slider[T]) GetValue() any {
+	return s.value()
+}
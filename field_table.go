@@ -0,0 +1,978 @@
+package huh
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// columnGap is the space between the columns of a table.
+const columnGap = "  "
+
+// TableRow is a row of a table select field, with a cell for each column.
+type TableRow[T comparable] struct {
+	Cells    []string
+	Value    T
+	selected bool
+}
+
+// NewTableRow returns a new table row.
+func NewTableRow[T comparable](value T, cells ...string) TableRow[T] {
+	return TableRow[T]{Cells: cells, Value: value}
+}
+
+// Selected sets whether the row is currently selected.
+func (r TableRow[T]) Selected(selected bool) TableRow[T] {
+	r.selected = selected
+	return r
+}
+
+// String returns the first cell of the row.
+func (r TableRow[T]) String() string {
+	if len(r.Cells) == 0 {
+		return ""
+	}
+	return r.Cells[0]
+}
+
+// cell returns the cell of the given column, or an empty string if the row is
+// short.
+func (r TableRow[T]) cell(column int) string {
+	if column < len(r.Cells) {
+		return r.Cells[column]
+	}
+	return ""
+}
+
+// TableSelect is a form field to select rows of a table.
+//
+// Rows are shown as aligned columns under a header. They can be sorted by
+// column using "s", and filtered across all the cells using "/". A single row
+// is selected with Value, or multiple rows with Values.
+type TableSelect[T comparable] struct {
+	id            int
+	accessor      Accessor[T]
+	multiAccessor Accessor[[]T]
+	key           string
+	hide          func() bool
+
+	viewport viewport.Model
+
+	// customization
+	title       Eval[string]
+	description Eval[string]
+	columns     []string
+	rows        []TableRow[T]
+	multiple    bool
+	limit       int
+
+	// error handling
+	validate      func(T) error
+	validateMulti func([]T) error
+	err           error
+
+	// state
+	visible    []int // indexes of the sorted and filtered rows
+	cursor     int
+	sortColumn int
+	sortDesc   bool
+	focused    bool
+	filtering  bool
+	filter     textinput.Model
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    TableSelectKeyMap
+}
+
+// NewTableSelect returns a new table select field.
+func NewTableSelect[T comparable]() *TableSelect[T] {
+	filter := textinput.New()
+	filter.Prompt = "/"
+
+	return &TableSelect[T]{
+		accessor:      &EmbeddedAccessor[T]{},
+		multiAccessor: &EmbeddedAccessor[[]T]{},
+		id:            nextID(),
+		title:         Eval[string]{cache: make(map[uint64]string)},
+		description:   Eval[string]{cache: make(map[uint64]string)},
+		validate:      func(T) error { return nil },
+		validateMulti: func([]T) error { return nil },
+		sortColumn:    -1,
+		filter:        filter,
+	}
+}
+
+// Value sets the value of the table select field, selecting a single row.
+func (t *TableSelect[T]) Value(value *T) *TableSelect[T] {
+	return t.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the table select field, selecting a single
+// row.
+func (t *TableSelect[T]) Accessor(accessor Accessor[T]) *TableSelect[T] {
+	t.accessor = accessor
+	t.multiple = false
+	t.selectValues()
+	return t
+}
+
+// Values sets the value of the table select field, selecting multiple rows.
+func (t *TableSelect[T]) Values(values *[]T) *TableSelect[T] {
+	return t.ValuesAccessor(NewPointerAccessor(values))
+}
+
+// ValuesAccessor sets the accessor of the table select field, selecting
+// multiple rows.
+func (t *TableSelect[T]) ValuesAccessor(accessor Accessor[[]T]) *TableSelect[T] {
+	t.multiAccessor = accessor
+	t.multiple = true
+	t.selectValues()
+	return t
+}
+
+// Key sets the key of the table select field.
+func (t *TableSelect[T]) Key(key string) *TableSelect[T] {
+	t.key = key
+	return t
+}
+
+// Title sets the title of the table select field.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (t *TableSelect[T]) Title(title string) *TableSelect[T] {
+	t.title.val = title
+	t.title.fn = nil
+	return t
+}
+
+// TitleFunc sets the title func of the table select field.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (t *TableSelect[T]) TitleFunc(f func() string, bindings any) *TableSelect[T] {
+	t.title.fn = f
+	t.title.bindings = bindings
+	return t
+}
+
+// Description sets the description of the table select field.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (t *TableSelect[T]) Description(description string) *TableSelect[T] {
+	t.description.val = description
+	t.description.fn = nil
+	return t
+}
+
+// DescriptionFunc sets the description func of the table select field.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (t *TableSelect[T]) DescriptionFunc(f func() string, bindings any) *TableSelect[T] {
+	t.description.fn = f
+	t.description.bindings = bindings
+	return t
+}
+
+// Columns sets the titles of the columns shown in the header.
+func (t *TableSelect[T]) Columns(columns ...string) *TableSelect[T] {
+	t.columns = columns
+	return t
+}
+
+// Rows sets the rows of the table select field.
+func (t *TableSelect[T]) Rows(rows ...TableRow[T]) *TableSelect[T] {
+	t.rows = rows
+	t.selectValues()
+	t.updateViewportSize()
+	return t
+}
+
+// SortBy sets the column the rows are sorted by. A negative column keeps the
+// rows in the order they were given.
+func (t *TableSelect[T]) SortBy(column int, descending bool) *TableSelect[T] {
+	t.sortColumn = column
+	t.sortDesc = descending
+	t.updateVisible()
+	return t
+}
+
+// Limit sets the number of rows that can be selected when selecting multiple
+// rows.
+func (t *TableSelect[T]) Limit(limit int) *TableSelect[T] {
+	t.limit = limit
+	return t
+}
+
+// Height sets the height of the table select field. If the rows don't fit,
+// they're scrollable.
+func (t *TableSelect[T]) Height(height int) *TableSelect[T] {
+	t.height = height
+	t.updateViewportSize()
+	return t
+}
+
+// Validate sets the validation function of the table select field when
+// selecting a single row.
+func (t *TableSelect[T]) Validate(validate func(T) error) *TableSelect[T] {
+	t.validate = validate
+	return t
+}
+
+// ValidateValues sets the validation function of the table select field when
+// selecting multiple rows.
+func (t *TableSelect[T]) ValidateValues(validate func([]T) error) *TableSelect[T] {
+	t.validateMulti = validate
+	return t
+}
+
+// Error returns the error of the table select field.
+func (t *TableSelect[T]) Error() error { return t.err }
+
+// Skip returns whether the table select field should be skipped or should be
+// blocking.
+func (t *TableSelect[T]) Skip() bool { return t.hidden() }
+
+// Hide sets whether the table select field is hidden.
+func (t *TableSelect[T]) Hide(hide bool) *TableSelect[T] {
+	return t.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the table select field is
+// hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (t *TableSelect[T]) HideFunc(hideFunc func() bool) *TableSelect[T] {
+	t.hide = hideFunc
+	return t
+}
+
+// hidden returns whether the table select field is hidden.
+func (t *TableSelect[T]) hidden() bool { return t.hide != nil && t.hide() }
+
+// Zoom returns whether the table select field should be zoomed.
+func (*TableSelect[T]) Zoom() bool { return false }
+
+// Focus focuses the table select field.
+func (t *TableSelect[T]) Focus() tea.Cmd {
+	t.focused = true
+	return nil
+}
+
+// Blur blurs the table select field.
+func (t *TableSelect[T]) Blur() tea.Cmd {
+	t.focused = false
+	t.err = t.check()
+	return nil
+}
+
+// Hovered returns the value of the row under the cursor, and a bool
+// indicating whether one was found.
+func (t *TableSelect[T]) Hovered() (T, bool) {
+	if t.cursor >= len(t.visible) {
+		var zero T
+		return zero, false
+	}
+	return t.rows[t.visible[t.cursor]].Value, true
+}
+
+// KeyBinds returns the help keybindings for the table select field.
+func (t *TableSelect[T]) KeyBinds() []key.Binding {
+	return []key.Binding{
+		t.keymap.Toggle,
+		t.keymap.Up,
+		t.keymap.Down,
+		t.keymap.Sort,
+		t.keymap.ReverseSort,
+		t.keymap.Filter,
+		t.keymap.SetFilter,
+		t.keymap.ClearFilter,
+		t.keymap.Prev,
+		t.keymap.Next,
+		t.keymap.Submit,
+	}
+}
+
+// Init initializes the table select field.
+func (t *TableSelect[T]) Init() tea.Cmd {
+	return nil
+}
+
+// Update updates the table select field.
+func (t *TableSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
+	t.updateViewportSize()
+
+	var cmd tea.Cmd
+	filterBefore := t.filter.Value()
+	if t.filtering {
+		t.filter, cmd = t.filter.Update(msg)
+	}
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		t.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		var cmds []tea.Cmd
+		if ok, hash := t.title.shouldUpdate(); ok {
+			t.title.bindingsHash = hash
+			if !t.title.loadFromCache() {
+				t.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: t.id, title: t.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := t.description.shouldUpdate(); ok {
+			t.description.bindingsHash = hash
+			if !t.description.loadFromCache() {
+				t.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: t.id, description: t.description.fn(), hash: hash}
+				})
+			}
+		}
+		return t, tea.Batch(cmds...)
+	case updateTitleMsg:
+		if msg.id == t.id && msg.hash == t.title.bindingsHash {
+			t.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == t.id && msg.hash == t.description.bindingsHash {
+			t.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		t.err = nil
+
+		// While filtering, text goes to the filter so only the up and down
+		// bindings that don't type anything move the cursor.
+		if t.filtering {
+			switch {
+			case key.Matches(msg, t.keymap.SetFilter):
+				if len(t.visible) == 0 {
+					t.filter.SetValue("")
+				}
+				t.setFiltering(false)
+			case msg.Text == "" && key.Matches(msg, t.keymap.Up):
+				t.moveCursor(-1)
+			case msg.Text == "" && key.Matches(msg, t.keymap.Down):
+				t.moveCursor(1)
+			}
+			t.updateVisible()
+			if t.filter.Value() != filterBefore {
+				t.cursor = 0
+				t.viewport.GotoTop()
+				t.updateValue()
+			}
+			return t, cmd
+		}
+
+		switch {
+		case key.Matches(msg, t.keymap.Filter):
+			t.setFiltering(true)
+			return t, t.filter.Focus()
+		case key.Matches(msg, t.keymap.ClearFilter):
+			t.clearFilter()
+		case key.Matches(msg, t.keymap.Up):
+			t.moveCursor(-1)
+		case key.Matches(msg, t.keymap.Down):
+			t.moveCursor(1)
+		case key.Matches(msg, t.keymap.HalfPageUp):
+			t.moveCursor(-max(t.viewport.Height()/2, 1))
+		case key.Matches(msg, t.keymap.HalfPageDown):
+			t.moveCursor(max(t.viewport.Height()/2, 1))
+		case key.Matches(msg, t.keymap.GotoTop):
+			t.moveCursor(-len(t.visible))
+		case key.Matches(msg, t.keymap.GotoBottom):
+			t.moveCursor(len(t.visible))
+		case key.Matches(msg, t.keymap.Sort):
+			t.sortColumn++
+			if t.sortColumn >= t.numColumns() {
+				t.sortColumn = -1
+			}
+			t.sortDesc = false
+			t.resort()
+		case key.Matches(msg, t.keymap.ReverseSort):
+			t.sortDesc = !t.sortDesc
+			t.resort()
+		case key.Matches(msg, t.keymap.Toggle):
+			if t.cursor >= len(t.visible) {
+				break
+			}
+			row := &t.rows[t.visible[t.cursor]]
+			if !row.selected && t.limit > 0 && t.numSelected() >= t.limit {
+				break
+			}
+			row.selected = !row.selected
+			t.updateValue()
+		case key.Matches(msg, t.keymap.Prev):
+			return t, PrevField
+		case key.Matches(msg, t.keymap.Next, t.keymap.Submit):
+			if t.err = t.check(); t.err != nil {
+				return t, nil
+			}
+			return t, NextField
+		}
+	}
+
+	return t, cmd
+}
+
+// moveCursor moves the cursor by the given number of rows, and updates the
+// value when selecting a single row.
+func (t *TableSelect[T]) moveCursor(delta int) {
+	t.cursor = max(min(t.cursor+delta, len(t.visible)-1), 0)
+	ensureVisible(&t.viewport, t.cursor, 1)
+	t.updateValue()
+}
+
+// resort sorts the rows again, keeping the cursor on the same row.
+func (t *TableSelect[T]) resort() {
+	hovered := -1
+	if t.cursor < len(t.visible) {
+		hovered = t.visible[t.cursor]
+	}
+	t.updateVisible()
+	if i := slices.Index(t.visible, hovered); i >= 0 {
+		t.cursor = i
+	}
+	t.keymap.ReverseSort.SetEnabled(t.sortColumn >= 0)
+	ensureVisible(&t.viewport, t.cursor, 1)
+}
+
+// updateVisible sorts and filters the rows.
+func (t *TableSelect[T]) updateVisible() {
+	t.visible = t.visible[:0]
+	for i, row := range t.rows {
+		if t.filterFunc(row) {
+			t.visible = append(t.visible, i)
+		}
+	}
+	if t.sortColumn >= 0 {
+		slices.SortStableFunc(t.visible, func(a, b int) int {
+			c := compareCells(t.rows[a].cell(t.sortColumn), t.rows[b].cell(t.sortColumn))
+			if t.sortDesc {
+				return -c
+			}
+			return c
+		})
+	}
+	t.cursor = max(min(t.cursor, len(t.visible)-1), 0)
+}
+
+// compareCells compares two cells as numbers when both are, and as case
+// insensitive strings otherwise.
+func compareCells(a, b string) int {
+	x, errA := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	y, errB := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if errA == nil && errB == nil {
+		return cmp.Compare(x, y)
+	}
+	return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// filterFunc returns true if any of the cells of the row matches the filter.
+func (t *TableSelect[T]) filterFunc(row TableRow[T]) bool {
+	filter := strings.ToLower(t.filter.Value())
+	return slices.ContainsFunc(row.Cells, func(cell string) bool {
+		return strings.Contains(strings.ToLower(cell), filter)
+	}) || filter == ""
+}
+
+// clearFilter clears the value of the filter.
+func (t *TableSelect[T]) clearFilter() {
+	t.filter.SetValue("")
+	t.setFiltering(false)
+	t.resort()
+}
+
+// setFiltering sets the filter of the table select field.
+func (t *TableSelect[T]) setFiltering(filtering bool) {
+	t.filtering = filtering
+	t.keymap.SetFilter.SetEnabled(filtering)
+	t.keymap.Filter.SetEnabled(!filtering)
+	t.keymap.ClearFilter.SetEnabled(!filtering && t.filter.Value() != "")
+}
+
+// selectValues marks the rows holding the value as selected, and moves the
+// cursor to the first of them.
+func (t *TableSelect[T]) selectValues() {
+	values := t.multiAccessor.Get()
+	if !t.multiple {
+		values = []T{t.accessor.Get()}
+	}
+	for i := range t.rows {
+		t.rows[i].selected = t.rows[i].selected || slices.Contains(values, t.rows[i].Value)
+	}
+	t.updateVisible()
+	for i, row := range t.visible {
+		if t.rows[row].selected {
+			t.cursor = i
+			break
+		}
+	}
+	t.updateValue()
+}
+
+// updateValue sets the value from the row under the cursor when selecting a
+// single row, or from the selected rows.
+func (t *TableSelect[T]) updateValue() {
+	if !t.multiple {
+		if value, ok := t.Hovered(); ok {
+			t.accessor.Set(value)
+		}
+		return
+	}
+	values := make([]T, 0)
+	for _, row := range t.rows {
+		if row.selected {
+			values = append(values, row.Value)
+		}
+	}
+	t.multiAccessor.Set(values)
+}
+
+// numSelected returns the number of selected rows.
+func (t *TableSelect[T]) numSelected() int {
+	var count int
+	for _, row := range t.rows {
+		if row.selected {
+			count++
+		}
+	}
+	return count
+}
+
+// numColumns returns the number of columns of the table.
+func (t *TableSelect[T]) numColumns() int {
+	n := len(t.columns)
+	for _, row := range t.rows {
+		n = max(n, len(row.Cells))
+	}
+	return n
+}
+
+// check checks the value with its validation function.
+func (t *TableSelect[T]) check() error {
+	if t.multiple {
+		return t.validateMulti(t.multiAccessor.Get())
+	}
+	if len(t.visible) == 0 {
+		return errors.New("no row selected")
+	}
+	return t.validate(t.accessor.Get())
+}
+
+// updateViewportSize updates the viewport size according to the Height setting
+// on this table select field.
+func (t *TableSelect[T]) updateViewportSize() {
+	if t.height > 0 {
+		yoffset := 1 // the header
+		if ss := t.titleView(); ss != "" {
+			yoffset += lipgloss.Height(ss)
+		}
+		if t.description.val != "" || t.description.fn != nil {
+			yoffset += lipgloss.Height(t.descriptionView())
+		}
+		t.viewport.SetHeight(max(minHeight, t.height-yoffset))
+	} else {
+		t.viewport.SetHeight(max(len(t.rows), minHeight))
+	}
+	if t.width > 0 {
+		t.viewport.SetWidth(t.width)
+	} else {
+		t.viewport.SetWidth(lipgloss.Width(t.rowsView()))
+	}
+	ensureVisible(&t.viewport, t.cursor, 1)
+}
+
+func (t *TableSelect[T]) activeStyles() *FieldStyles {
+	theme := t.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if t.focused {
+		return &theme.Theme(t.hasDarkBg).Focused
+	}
+	return &theme.Theme(t.hasDarkBg).Blurred
+}
+
+func (t *TableSelect[T]) titleView() string {
+	var (
+		styles   = t.activeStyles()
+		sb       = strings.Builder{}
+		maxWidth = t.width - styles.Base.GetHorizontalFrameSize()
+	)
+	if t.filtering {
+		sb.WriteString(t.filter.View())
+	} else if t.filter.Value() != "" {
+		sb.WriteString(styles.Description.Render("/" + t.filter.Value()))
+	} else {
+		sb.WriteString(styles.Title.Render(wrap(t.title.val, maxWidth)))
+	}
+	if t.err != nil {
+		sb.WriteString(styles.ErrorIndicator.String())
+	}
+	return sb.String()
+}
+
+func (t *TableSelect[T]) descriptionView() string {
+	maxWidth := t.width - t.activeStyles().Base.GetHorizontalFrameSize()
+	return t.activeStyles().Description.Render(wrap(t.description.val, maxWidth))
+}
+
+// columnWidths returns the width of each column, fitting the header and every
+// row so that columns don't move while filtering.
+func (t *TableSelect[T]) columnWidths() []int {
+	widths := make([]int, t.numColumns())
+	for i := range widths {
+		if i < len(t.columns) {
+			widths[i] = lipgloss.Width(t.columns[i]) + lipgloss.Width(" ▲")
+		}
+		for _, row := range t.rows {
+			widths[i] = max(widths[i], lipgloss.Width(row.cell(i)))
+		}
+	}
+	return widths
+}
+
+// prefix returns the selector and, when selecting multiple rows, the
+// selection prefix of a row.
+func (t *TableSelect[T]) prefix(row TableRow[T], current bool) string {
+	styles := t.activeStyles()
+	var sb strings.Builder
+	if !t.multiple {
+		if current {
+			return styles.SelectSelector.String()
+		}
+		return strings.Repeat(" ", lipgloss.Width(styles.SelectSelector.String()))
+	}
+	if current {
+		sb.WriteString(styles.MultiSelectSelector.String())
+	} else {
+		sb.WriteString(strings.Repeat(" ", lipgloss.Width(styles.MultiSelectSelector.String())))
+	}
+	if row.selected {
+		sb.WriteString(styles.SelectedPrefix.String())
+	} else {
+		sb.WriteString(styles.UnselectedPrefix.String())
+	}
+	return sb.String()
+}
+
+// line joins cells padded to the column widths, truncated to the field width.
+func (t *TableSelect[T]) line(cells []string, widths []int, prefixWidth int) string {
+	padded := make([]string, len(widths))
+	for i, width := range widths {
+		var cell string
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		padded[i] = cell + strings.Repeat(" ", max(width-lipgloss.Width(cell), 0))
+	}
+	line := strings.TrimRight(strings.Join(padded, columnGap), " ")
+	if t.width > 0 {
+		maxWidth := t.width - t.activeStyles().Base.GetHorizontalFrameSize() - prefixWidth
+		line = ansi.Truncate(line, max(maxWidth, 1), "…")
+	}
+	return line
+}
+
+func (t *TableSelect[T]) headerView() string {
+	styles := t.activeStyles()
+	headers := slices.Clone(t.columns)
+	if t.sortColumn >= 0 && t.sortColumn < len(headers) {
+		if t.sortDesc {
+			headers[t.sortColumn] += " ▼"
+		} else {
+			headers[t.sortColumn] += " ▲"
+		}
+	}
+	prefixWidth := lipgloss.Width(t.prefix(TableRow[T]{}, false))
+	return strings.Repeat(" ", prefixWidth) +
+		styles.TableHeader.Render(t.line(headers, t.columnWidths(), prefixWidth))
+}
+
+func (t *TableSelect[T]) rowsView() string {
+	styles := t.activeStyles()
+	widths := t.columnWidths()
+	if len(t.visible) == 0 {
+		return styles.TextInput.Placeholder.Render("No matches")
+	}
+
+	lines := make([]string, len(t.visible))
+	for i, index := range t.visible {
+		row := t.rows[index]
+		current := t.focused && i == t.cursor
+		prefix := t.prefix(row, current)
+		line := t.line(row.Cells, widths, lipgloss.Width(prefix))
+		if current || (t.multiple && row.selected) {
+			line = styles.SelectedOption.Render(line)
+		} else {
+			line = styles.UnselectedOption.Render(line)
+		}
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// View renders the table select field.
+func (t *TableSelect[T]) View() string {
+	styles := t.activeStyles()
+	t.viewport.SetContent(t.rowsView())
+
+	var parts []string
+	if t.title.val != "" || t.title.fn != nil || t.filtering || t.filter.Value() != "" {
+		parts = append(parts, t.titleView())
+	}
+	if t.description.val != "" || t.description.fn != nil {
+		parts = append(parts, t.descriptionView())
+	}
+	if len(t.columns) > 0 {
+		parts = append(parts, t.headerView())
+	}
+	parts = append(parts, t.viewport.View())
+	return styles.Base.Width(t.width).Height(t.height).
+		Render(strings.Join(parts, "\n"))
+}
+
+// Run runs the table select field.
+func (t *TableSelect[T]) Run() error {
+	return Run(t)
+}
+
+// printRows prints the header and the numbered rows.
+func (t *TableSelect[T]) printRows(w io.Writer) {
+	styles := t.activeStyles()
+	widths := t.columnWidths()
+	marker := func(row TableRow[T]) string {
+		if !t.multiple {
+			return ""
+		}
+		if row.selected {
+			return "✓ "
+		}
+		return "  "
+	}
+	indent := len(strconv.Itoa(len(t.rows))) + len(". ") + lipgloss.Width(marker(TableRow[T]{}))
+
+	var sb strings.Builder
+	if len(t.columns) > 0 {
+		sb.WriteString(strings.Repeat(" ", indent) + t.line(t.columns, widths, indent) + "\n")
+	}
+	for i, row := range t.rows {
+		number := fmt.Sprintf("%*d. ", indent-len(". ")-lipgloss.Width(marker(row)), i+1)
+		line := number + marker(row) + t.line(row.Cells, widths, indent)
+		if t.multiple && row.selected {
+			line = styles.SelectedOption.Render(line)
+		}
+		sb.WriteString(line + "\n")
+	}
+	if t.multiple {
+		sb.WriteString(fmt.Sprintf("%*d.   Confirm selection\n", indent-len(". ")-2, 0))
+	}
+	_, _ = fmt.Fprint(w, sb.String())
+}
+
+// RunAccessible runs the table select field in accessible mode.
+func (t *TableSelect[T]) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := t.activeStyles()
+	_, _ = fmt.Fprintln(w, styles.Title.
+		PaddingRight(1).
+		Render(cmp.Or(t.title.val, "Select:")))
+
+	if len(t.rows) == 0 {
+		return errors.New("no rows to select from")
+	}
+
+	if !t.multiple {
+		t.printRows(w)
+		var defaultValue *int
+		if _, ok := t.accessor.(*PointerAccessor[T]); ok {
+			if i := slices.IndexFunc(t.rows, func(row TableRow[T]) bool { return row.selected }); i >= 0 {
+				defaultValue = new(int)
+				*defaultValue = i + 1
+			}
+		}
+		prompt := fmt.Sprintf("Enter a number between %d and %d: ", 1, len(t.rows))
+		for {
+			choice := accessibility.PromptInt(w, r, prompt, 1, len(t.rows), defaultValue)
+			value := t.rows[choice-1].Value
+			if err := t.validate(value); err != nil {
+				_, _ = fmt.Fprintln(w, err.Error())
+				_, _ = fmt.Fprintln(w)
+				continue
+			}
+			t.accessor.Set(value)
+			return nil
+		}
+	}
+
+	for {
+		t.printRows(w)
+		prompt := fmt.Sprintf("Enter a number between %d and %d: ", 0, len(t.rows))
+		choice := accessibility.PromptInt(w, r, prompt, 0, len(t.rows), nil)
+		if choice <= 0 {
+			t.updateValue()
+			if err := t.validateMulti(t.multiAccessor.Get()); err != nil {
+				_, _ = fmt.Fprintln(w, err)
+				continue
+			}
+			return nil
+		}
+		row := &t.rows[choice-1]
+		if !row.selected && t.limit > 0 && t.numSelected() >= t.limit {
+			_, _ = fmt.Fprintf(w, "You can't select more than %d rows.\n", t.limit)
+			_, _ = fmt.Fprintln(w)
+			continue
+		}
+		row.selected = !row.selected
+		_, _ = fmt.Fprintln(w)
+	}
+}
+
+// options returns the rows as options keyed by their first cell.
+func (t *TableSelect[T]) options() []Option[T] {
+	options := make([]Option[T], len(t.rows))
+	for i, row := range t.rows {
+		options[i] = NewOption(row.String(), row.Value)
+	}
+	return options
+}
+
+// answer sets the value of the table select field from an answers source.
+//
+// Rows are answered by value, first cell, or value formatted as a string. A
+// string is treated as a comma separated list when selecting multiple rows.
+func (t *TableSelect[T]) answer(value any) error {
+	if !t.multiple {
+		option, err := answerOption(t.options(), value)
+		if err != nil {
+			return err
+		}
+		t.accessor.Set(option.Value)
+		t.selectValues()
+		return t.check()
+	}
+
+	values := make([]T, 0)
+	for _, item := range answerList(value) {
+		option, err := answerOption(t.options(), item)
+		if err != nil {
+			return err
+		}
+		values = append(values, option.Value)
+	}
+	if t.limit > 0 && len(values) > t.limit {
+		return fmt.Errorf("can't select more than %d rows", t.limit)
+	}
+	for i := range t.rows {
+		t.rows[i].selected = false
+	}
+	t.multiAccessor.Set(values)
+	t.selectValues()
+	return t.check()
+}
+
+// review returns the title and value shown on the form's review page.
+func (t *TableSelect[T]) review() (string, string) {
+	var keys []string
+	for _, row := range t.rows {
+		if (t.multiple && row.selected) || (!t.multiple && row.Value == t.accessor.Get()) {
+			keys = append(keys, row.String())
+		}
+	}
+	if !t.multiple && len(keys) == 0 {
+		return t.title.val, fmt.Sprint(t.accessor.Get())
+	}
+	return t.title.val, strings.Join(keys, ", ")
+}
+
+// WithTheme sets the theme of the table select field.
+func (t *TableSelect[T]) WithTheme(theme Theme) Field {
+	if t.theme != nil {
+		return t
+	}
+	t.theme = theme
+	styles := t.theme.Theme(t.hasDarkBg)
+
+	st := t.filter.Styles()
+	st.Cursor.Color = styles.Focused.TextInput.Cursor.GetForeground()
+	st.Focused.Prompt = styles.Focused.TextInput.Prompt
+	st.Focused.Text = styles.Focused.TextInput.Text
+	st.Focused.Placeholder = styles.Focused.TextInput.Placeholder
+	t.filter.SetStyles(st)
+
+	t.updateViewportSize()
+	return t
+}
+
+// WithKeyMap sets the keymap on a table select field.
+func (t *TableSelect[T]) WithKeyMap(k *KeyMap) Field {
+	t.keymap = k.TableSelect
+	t.keymap.Toggle.SetEnabled(t.multiple)
+	t.keymap.ReverseSort.SetEnabled(t.sortColumn >= 0)
+	return t
+}
+
+// WithWidth sets the width of the table select field.
+func (t *TableSelect[T]) WithWidth(width int) Field {
+	t.width = width
+	t.updateViewportSize()
+	return t
+}
+
+// WithHeight sets the height of the table select field.
+func (t *TableSelect[T]) WithHeight(height int) Field {
+	return t.Height(height)
+}
+
+// WithPosition sets the position of the table select field.
+func (t *TableSelect[T]) WithPosition(p FieldPosition) Field {
+	if t.filtering {
+		return t
+	}
+	t.keymap.Prev.SetEnabled(!p.IsFirst())
+	t.keymap.Next.SetEnabled(!p.IsLast())
+	t.keymap.Submit.SetEnabled(p.IsLast())
+	return t
+}
+
+// GetKey returns the key of the field.
+func (t *TableSelect[T]) GetKey() string { return t.key }
+
+// GetValue returns the value of the field: a single value, or a slice of
+// values when selecting multiple rows.
+func (t *TableSelect[T]) GetValue() any {
+	if t.multiple {
+		return t.multiAccessor.Get()
+	}
+	return t.accessor.Get()
+}
+
+// GetFiltering returns the filtering state of the field.
+func (t *TableSelect[T]) GetFiltering() bool {
+	return t.filtering
+}
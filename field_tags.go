@@ -0,0 +1,555 @@
+package huh
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+// Tags is a form field to enter a list of tags.
+//
+// Typing a tag then enter or a comma adds it as a chip, and backspace on an
+// empty input removes the last one. Tags are completed from suggestions, and
+// can be restricted to them.
+type Tags struct {
+	accessor Accessor[[]string]
+	key      string
+	hide     func() bool
+	id       int
+
+	// customization
+	title       Eval[string]
+	description Eval[string]
+	suggestions Eval[[]string]
+	restrict    bool
+
+	// error handling
+	validate func([]string) error
+	err      error
+
+	// state
+	tags      []string
+	textinput textinput.Model
+	focused   bool
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    TagsKeyMap
+}
+
+// NewTags returns a new tags field.
+func NewTags() *Tags {
+	input := textinput.New()
+	input.Prompt = ""
+
+	return &Tags{
+		accessor:    &EmbeddedAccessor[[]string]{},
+		id:          nextID(),
+		title:       Eval[string]{cache: make(map[uint64]string)},
+		description: Eval[string]{cache: make(map[uint64]string)},
+		suggestions: Eval[[]string]{cache: make(map[uint64][]string)},
+		validate:    func([]string) error { return nil },
+		textinput:   input,
+	}
+}
+
+// Value sets the value of the tags field.
+func (t *Tags) Value(value *[]string) *Tags {
+	return t.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the tags field.
+func (t *Tags) Accessor(accessor Accessor[[]string]) *Tags {
+	t.accessor = accessor
+	t.tags = slices.Clone(t.accessor.Get())
+	t.updateSuggestions()
+	return t
+}
+
+// Key sets the key of the tags field.
+func (t *Tags) Key(key string) *Tags {
+	t.key = key
+	return t
+}
+
+// Title sets the title of the tags field.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (t *Tags) Title(title string) *Tags {
+	t.title.val = title
+	t.title.fn = nil
+	return t
+}
+
+// TitleFunc sets the title func of the tags field.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (t *Tags) TitleFunc(f func() string, bindings any) *Tags {
+	t.title.fn = f
+	t.title.bindings = bindings
+	return t
+}
+
+// Description sets the description of the tags field.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (t *Tags) Description(description string) *Tags {
+	t.description.val = description
+	t.description.fn = nil
+	return t
+}
+
+// DescriptionFunc sets the description func of the tags field.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (t *Tags) DescriptionFunc(f func() string, bindings any) *Tags {
+	t.description.fn = f
+	t.description.bindings = bindings
+	return t
+}
+
+// Placeholder sets the placeholder of the input of the tags field.
+func (t *Tags) Placeholder(placeholder string) *Tags {
+	t.textinput.Placeholder = placeholder
+	return t
+}
+
+// Suggestions sets the tags suggested for autocomplete.
+//
+// The suggestions are static for dynamic suggestions use `SuggestionsFunc`.
+func (t *Tags) Suggestions(suggestions []string) *Tags {
+	t.suggestions.val = suggestions
+	t.suggestions.fn = nil
+	t.updateSuggestions()
+	return t
+}
+
+// SuggestionsFunc sets the suggestions func of the tags suggested for
+// autocomplete.
+//
+// The SuggestionsFunc will be re-evaluated when the binding of the
+// SuggestionsFunc changes. This is useful when you want to display dynamic
+// suggestions when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (t *Tags) SuggestionsFunc(f func() []string, bindings any) *Tags {
+	t.suggestions.fn = f
+	t.suggestions.bindings = bindings
+	t.suggestions.loading = true
+	return t
+}
+
+// Restrict sets whether tags are restricted to the suggested ones.
+func (t *Tags) Restrict(restrict bool) *Tags {
+	t.restrict = restrict
+	return t
+}
+
+// Validate sets the validation function of the tags field.
+func (t *Tags) Validate(validate func([]string) error) *Tags {
+	t.validate = validate
+	return t
+}
+
+// Error returns the error of the tags field.
+func (t *Tags) Error() error { return t.err }
+
+// Skip returns whether the tags field should be skipped or should be blocking.
+func (t *Tags) Skip() bool { return t.hidden() }
+
+// Hide sets whether the tags field is hidden.
+func (t *Tags) Hide(hide bool) *Tags {
+	return t.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the tags field is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (t *Tags) HideFunc(hideFunc func() bool) *Tags {
+	t.hide = hideFunc
+	return t
+}
+
+// hidden returns whether the tags field is hidden.
+func (t *Tags) hidden() bool { return t.hide != nil && t.hide() }
+
+// Zoom returns whether the tags field should be zoomed.
+func (*Tags) Zoom() bool { return false }
+
+// Focus focuses the tags field.
+func (t *Tags) Focus() tea.Cmd {
+	t.focused = true
+	return t.textinput.Focus()
+}
+
+// Blur blurs the tags field.
+func (t *Tags) Blur() tea.Cmd {
+	t.focused = false
+	t.textinput.Blur()
+	t.textinput.SetValue("")
+	t.err = t.check(t.tags)
+	return nil
+}
+
+// KeyBinds returns the help message for the tags field.
+func (t *Tags) KeyBinds() []key.Binding {
+	return []key.Binding{t.keymap.AcceptSuggestion, t.keymap.Add, t.keymap.Remove, t.keymap.Prev, t.keymap.Submit, t.keymap.Next}
+}
+
+// Init initializes the tags field.
+func (t *Tags) Init() tea.Cmd {
+	t.textinput.Blur()
+	return nil
+}
+
+// Update updates the tags field.
+func (t *Tags) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		t.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		if ok, hash := t.title.shouldUpdate(); ok {
+			t.title.bindingsHash = hash
+			if !t.title.loadFromCache() {
+				t.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: t.id, title: t.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := t.description.shouldUpdate(); ok {
+			t.description.bindingsHash = hash
+			if !t.description.loadFromCache() {
+				t.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: t.id, description: t.description.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := t.suggestions.shouldUpdate(); ok {
+			t.suggestions.bindingsHash = hash
+			if t.suggestions.loadFromCache() {
+				t.updateSuggestions()
+			} else {
+				t.suggestions.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateSuggestionsMsg{id: t.id, suggestions: t.suggestions.fn(), hash: hash}
+				})
+			}
+		}
+		return t, tea.Batch(cmds...)
+	case updateTitleMsg:
+		if msg.id == t.id && msg.hash == t.title.bindingsHash {
+			t.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == t.id && msg.hash == t.description.bindingsHash {
+			t.description.update(msg.description)
+		}
+	case updateSuggestionsMsg:
+		if msg.id == t.id && msg.hash == t.suggestions.bindingsHash {
+			t.suggestions.update(msg.suggestions)
+			t.updateSuggestions()
+		}
+	case tea.KeyPressMsg:
+		t.err = nil
+		typed := strings.TrimSpace(t.textinput.Value())
+		switch {
+		case key.Matches(msg, t.keymap.Add) && typed != "":
+			t.err = t.add(typed)
+			return t, nil
+		case key.Matches(msg, t.keymap.Add) && !key.Matches(msg, t.keymap.Next, t.keymap.Submit):
+			// there's nothing to add.
+			return t, nil
+		case key.Matches(msg, t.keymap.Remove) && t.textinput.Value() == "":
+			if len(t.tags) > 0 {
+				t.tags = t.tags[:len(t.tags)-1]
+				t.accessor.Set(slices.Clone(t.tags))
+				t.updateSuggestions()
+			}
+			return t, nil
+		case key.Matches(msg, t.keymap.Prev):
+			return t, PrevField
+		case key.Matches(msg, t.keymap.Next, t.keymap.Submit):
+			if typed != "" {
+				if t.err = t.add(typed); t.err != nil {
+					return t, nil
+				}
+			}
+			if t.err = t.check(t.tags); t.err != nil {
+				return t, nil
+			}
+			return t, NextField
+		}
+	}
+
+	var cmd tea.Cmd
+	t.textinput, cmd = t.textinput.Update(msg)
+	cmds = append(cmds, cmd)
+	return t, tea.Batch(cmds...)
+}
+
+// add adds a tag, unless it's already there. Restricted tags take the
+// spelling of the suggestion they match.
+func (t *Tags) add(tag string) error {
+	if t.restrict {
+		known, ok := t.known(tag)
+		if !ok {
+			return fmt.Errorf("%q isn't a known tag", tag)
+		}
+		tag = known
+	}
+	t.textinput.SetValue("")
+	if !slices.Contains(t.tags, tag) {
+		t.tags = append(t.tags, tag)
+		t.accessor.Set(slices.Clone(t.tags))
+		t.updateSuggestions()
+	}
+	return nil
+}
+
+// known returns the suggestion matching a tag, regardless of case.
+func (t *Tags) known(tag string) (string, bool) {
+	suggestions := t.suggestions.val
+	if t.suggestions.fn != nil && t.suggestions.loading {
+		suggestions = t.suggestions.fn()
+	}
+	for _, s := range suggestions {
+		if strings.EqualFold(s, tag) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// updateSuggestions suggests the tags that aren't added yet.
+func (t *Tags) updateSuggestions() {
+	suggestions := slices.DeleteFunc(slices.Clone(t.suggestions.val), func(s string) bool {
+		return slices.Contains(t.tags, s)
+	})
+	t.textinput.ShowSuggestions = len(suggestions) > 0
+	t.textinput.KeyMap.AcceptSuggestion.SetEnabled(len(suggestions) > 0)
+	t.textinput.SetSuggestions(suggestions)
+}
+
+// parseTags returns the tags of a list, trimmed, without blanks or
+// duplicates.
+func parseTags(list []string) []string {
+	var tags []string
+	for _, tag := range list {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// check checks that restricted tags are known, then the validation function.
+func (t *Tags) check(tags []string) error {
+	if t.restrict {
+		for _, tag := range tags {
+			if _, ok := t.known(tag); !ok {
+				return fmt.Errorf("%q isn't a known tag", tag)
+			}
+		}
+	}
+	return t.validate(tags)
+}
+
+// setTags sets checked tags, in the spelling of the suggestions if restricted.
+func (t *Tags) setTags(tags []string) {
+	if t.restrict {
+		for i, tag := range tags {
+			tags[i], _ = t.known(tag)
+		}
+		tags = parseTags(tags)
+	}
+	t.tags = tags
+	t.accessor.Set(slices.Clone(t.tags))
+	t.updateSuggestions()
+}
+
+func (t *Tags) activeStyles() *FieldStyles {
+	theme := t.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if t.focused {
+		return &theme.Theme(t.hasDarkBg).Focused
+	}
+	return &theme.Theme(t.hasDarkBg).Blurred
+}
+
+// tagsView renders the chips of the tags, wrapped to the width of the field,
+// followed by the input.
+func (t *Tags) tagsView(maxWidth int) string {
+	styles := t.activeStyles()
+
+	st := t.textinput.Styles()
+	st.Cursor.Color = styles.TextInput.Cursor.GetForeground()
+	st.Focused.Prompt = styles.TextInput.Prompt
+	st.Focused.Text = styles.TextInput.Text
+	st.Focused.Placeholder = styles.TextInput.Placeholder
+	t.textinput.SetStyles(st)
+
+	parts := make([]string, 0, len(t.tags)+1)
+	for _, tag := range t.tags {
+		parts = append(parts, styles.Tag.Render(tag))
+	}
+	if t.focused || len(t.tags) == 0 {
+		parts = append(parts, t.textinput.View())
+	}
+
+	var lines []string
+	var line string
+	for _, part := range parts {
+		switch {
+		case line == "":
+			line = part
+		case maxWidth > 0 && lipgloss.Width(line)+1+lipgloss.Width(part) > maxWidth:
+			lines = append(lines, line)
+			line = part
+		default:
+			line += " " + part
+		}
+	}
+	return strings.Join(append(lines, line), "\n")
+}
+
+// View renders the tags field.
+func (t *Tags) View() string {
+	styles := t.activeStyles()
+	maxWidth := t.width - styles.Base.GetHorizontalFrameSize()
+
+	var sb strings.Builder
+	if t.title.val != "" || t.title.fn != nil {
+		sb.WriteString(styles.Title.Render(wrap(t.title.val, maxWidth)))
+		if t.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if t.description.val != "" || t.description.fn != nil {
+		sb.WriteString(styles.Description.Render(wrap(t.description.val, maxWidth)))
+		sb.WriteString("\n")
+	}
+	sb.WriteString(t.tagsView(maxWidth))
+
+	return styles.Base.
+		Width(t.width).
+		Height(t.height).
+		Render(sb.String())
+}
+
+// Run runs the tags field.
+func (t *Tags) Run() error {
+	return Run(t)
+}
+
+// RunAccessible runs the tags field in accessible mode.
+//
+// Tags are entered as a comma-separated list.
+func (t *Tags) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := t.activeStyles()
+	prompt := styles.Title.
+		PaddingRight(1).
+		Render(cmp.Or(t.title.val, "Tags:"))
+	prompt += styles.Description.Render("(comma-separated)") + " "
+
+	if t.restrict {
+		suggestions := t.suggestions.val
+		if t.suggestions.fn != nil {
+			suggestions = t.suggestions.fn()
+		}
+		_, _ = fmt.Fprintf(w, "Known tags: %s\n", strings.Join(suggestions, ", "))
+	}
+
+	value := accessibility.PromptString(w, r, prompt, strings.Join(t.tags, ", "), func(input string) error {
+		return t.check(parseTags(strings.Split(input, ",")))
+	})
+	t.setTags(parseTags(strings.Split(value, ",")))
+	return nil
+}
+
+// answer sets the value of the tags field from an answers source.
+func (t *Tags) answer(value any) error {
+	tags := parseTags(answerList(value))
+	if err := t.check(tags); err != nil {
+		return err
+	}
+	t.setTags(tags)
+	return nil
+}
+
+// review returns the title and value shown on the form's review page.
+func (t *Tags) review() (string, string) {
+	return t.title.val, strings.Join(t.tags, ", ")
+}
+
+// WithKeyMap sets the keymap on a tags field.
+func (t *Tags) WithKeyMap(k *KeyMap) Field {
+	t.keymap = k.Tags
+	t.textinput.KeyMap.AcceptSuggestion = t.keymap.AcceptSuggestion
+	t.updateSuggestions()
+	return t
+}
+
+// WithTheme sets the theme of the tags field.
+func (t *Tags) WithTheme(theme Theme) Field {
+	if t.theme != nil {
+		return t
+	}
+	t.theme = theme
+	return t
+}
+
+// WithWidth sets the width of the tags field.
+func (t *Tags) WithWidth(width int) Field {
+	t.width = width
+	return t
+}
+
+// WithHeight sets the height of the tags field.
+func (t *Tags) WithHeight(height int) Field {
+	t.height = height
+	return t
+}
+
+// WithPosition sets the position of the tags field.
+func (t *Tags) WithPosition(pos FieldPosition) Field {
+	t.keymap.Prev.SetEnabled(!pos.IsFirst())
+	t.keymap.Next.SetEnabled(!pos.IsLast())
+	t.keymap.Submit.SetEnabled(pos.IsLast())
+	return t
+}
+
+// GetKey returns the key of the field.
+func (t *Tags) GetKey() string { return t.key }
+
+// GetValue returns the value of the field.
+func (t *Tags) GetValue() any {
+	return t.accessor.Get()
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"unicode/utf8"
 
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/textarea"
@@ -24,6 +25,7 @@ import (
 type Text struct {
 	accessor Accessor[string]
 	key      string
+	hide     func() bool
 	id       int
 
 	title       Eval[string]
@@ -228,7 +230,24 @@ func (t *Text) EditorExtension(extension string) *Text {
 func (t *Text) Error() error { return t.err }
 
 // Skip returns whether the textarea should be skipped or should be blocking.
-func (*Text) Skip() bool { return false }
+func (t *Text) Skip() bool { return t.hidden() }
+
+// Hide sets whether the text is hidden.
+func (t *Text) Hide(hide bool) *Text {
+	return t.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the text is hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (t *Text) HideFunc(hideFunc func() bool) *Text {
+	t.hide = hideFunc
+	return t
+}
+
+// hidden returns whether the text is hidden.
+func (t *Text) hidden() bool { return t.hide != nil && t.hide() }
 
 // Zoom returns whether the note should be zoomed.
 func (*Text) Zoom() bool { return false }
@@ -432,7 +451,7 @@ func (t *Text) RunAccessible(w io.Writer, r io.Reader) error {
 				return err
 			}
 
-			if t.textarea.CharLimit > 0 && len(input) > t.textarea.CharLimit {
+			if t.textarea.CharLimit > 0 && utf8.RuneCountInString(input) > t.textarea.CharLimit {
 				return fmt.Errorf("Input cannot exceed %d characters", t.textarea.CharLimit)
 			}
 			return nil
@@ -441,6 +460,22 @@ func (t *Text) RunAccessible(w io.Writer, r io.Reader) error {
 	return nil
 }
 
+// answer sets the value of the text field from an answers source.
+func (t *Text) answer(value any) error {
+	t.textarea.SetValue(answerString(value))
+	t.accessor.Set(answerString(value))
+	input := t.accessor.Get()
+	if t.textarea.CharLimit > 0 && utf8.RuneCountInString(input) > t.textarea.CharLimit {
+		return fmt.Errorf("input cannot exceed %d characters", t.textarea.CharLimit)
+	}
+	return t.validate(input)
+}
+
+// review returns the title and value shown on the form's review page.
+func (t *Text) review() (string, string) {
+	return t.title.val, strings.ReplaceAll(t.accessor.Get(), "\n", " ")
+}
+
 // WithTheme sets the theme on a text field.
 func (t *Text) WithTheme(theme Theme) Field {
 	if t.theme != nil {
@@ -0,0 +1,965 @@
+package huh
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+// Tree expanders, shown before the key of a node.
+const (
+	treeCollapsed = "▸ "
+	treeExpanded  = "▾ "
+	treeLeaf      = "  "
+	treeIndent    = "  "
+)
+
+// treeNode is a node of a tree select field.
+type treeNode[T comparable] struct {
+	option   Option[T]
+	parent   *treeNode[T]
+	children []*treeNode[T]
+	depth    int
+	loaded   bool
+	expanded bool
+}
+
+// path returns the keys from the root to the node.
+func (n *treeNode[T]) path() []string {
+	if n.parent == nil {
+		return []string{n.option.Key}
+	}
+	return append(n.parent.path(), n.option.Key)
+}
+
+// TreeSelect is a form field to select from hierarchical options, such as
+// organizations, their teams and their repositories.
+//
+// The root options are given with Options, and the children of a node are
+// loaded with the Children func the first time the node is expanded.
+// Filtering with "/" matches the loaded nodes and keeps their ancestors
+// visible. A single node is selected with Value, or multiple nodes with Values.
+type TreeSelect[T comparable] struct {
+	id            int
+	accessor      Accessor[T]
+	multiAccessor Accessor[[]T]
+	key           string
+	hide          func() bool
+
+	viewport viewport.Model
+
+	// customization
+	title       Eval[string]
+	description Eval[string]
+	roots       []*treeNode[T]
+	children    func(parent T) []Option[T]
+	multiple    bool
+	cascade     bool
+
+	// error handling
+	validate      func(T) error
+	validateMulti func([]T) error
+	err           error
+
+	// state
+	visible   []*treeNode[T]
+	cursor    int
+	focused   bool
+	filtering bool
+	filter    textinput.Model
+
+	// options
+	width     int
+	height    int
+	theme     Theme
+	hasDarkBg bool
+	keymap    TreeSelectKeyMap
+}
+
+// NewTreeSelect returns a new tree select field.
+func NewTreeSelect[T comparable]() *TreeSelect[T] {
+	filter := textinput.New()
+	filter.Prompt = "/"
+
+	return &TreeSelect[T]{
+		accessor:      &EmbeddedAccessor[T]{},
+		multiAccessor: &EmbeddedAccessor[[]T]{},
+		id:            nextID(),
+		title:         Eval[string]{cache: make(map[uint64]string)},
+		description:   Eval[string]{cache: make(map[uint64]string)},
+		validate:      func(T) error { return nil },
+		validateMulti: func([]T) error { return nil },
+		filter:        filter,
+	}
+}
+
+// Value sets the value of the tree select field, selecting a single node.
+func (t *TreeSelect[T]) Value(value *T) *TreeSelect[T] {
+	return t.Accessor(NewPointerAccessor(value))
+}
+
+// Accessor sets the accessor of the tree select field, selecting a single
+// node.
+func (t *TreeSelect[T]) Accessor(accessor Accessor[T]) *TreeSelect[T] {
+	t.accessor = accessor
+	t.multiple = false
+	t.selectValues()
+	return t
+}
+
+// Values sets the value of the tree select field, selecting multiple nodes.
+func (t *TreeSelect[T]) Values(values *[]T) *TreeSelect[T] {
+	return t.ValuesAccessor(NewPointerAccessor(values))
+}
+
+// ValuesAccessor sets the accessor of the tree select field, selecting
+// multiple nodes.
+func (t *TreeSelect[T]) ValuesAccessor(accessor Accessor[[]T]) *TreeSelect[T] {
+	t.multiAccessor = accessor
+	t.multiple = true
+	t.selectValues()
+	return t
+}
+
+// Key sets the key of the tree select field.
+func (t *TreeSelect[T]) Key(key string) *TreeSelect[T] {
+	t.key = key
+	return t
+}
+
+// Title sets the title of the tree select field.
+//
+// The Title is static for dynamic Title use `TitleFunc`.
+func (t *TreeSelect[T]) Title(title string) *TreeSelect[T] {
+	t.title.val = title
+	t.title.fn = nil
+	return t
+}
+
+// TitleFunc sets the title func of the tree select field.
+//
+// The TitleFunc will be re-evaluated when the binding of the TitleFunc changes.
+// This is useful when you want to display dynamic content and update the title
+// when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (t *TreeSelect[T]) TitleFunc(f func() string, bindings any) *TreeSelect[T] {
+	t.title.fn = f
+	t.title.bindings = bindings
+	return t
+}
+
+// Description sets the description of the tree select field.
+//
+// The Description is static for dynamic Description use `DescriptionFunc`.
+func (t *TreeSelect[T]) Description(description string) *TreeSelect[T] {
+	t.description.val = description
+	t.description.fn = nil
+	return t
+}
+
+// DescriptionFunc sets the description func of the tree select field.
+//
+// The DescriptionFunc will be re-evaluated when the binding of the
+// DescriptionFunc changes. This is useful when you want to display dynamic
+// content and update the description when another part of your form changes.
+//
+// See README#Dynamic for more usage information.
+func (t *TreeSelect[T]) DescriptionFunc(f func() string, bindings any) *TreeSelect[T] {
+	t.description.fn = f
+	t.description.bindings = bindings
+	return t
+}
+
+// Options sets the root options of the tree select field.
+func (t *TreeSelect[T]) Options(options ...Option[T]) *TreeSelect[T] {
+	t.roots = t.newNodes(nil, options)
+	t.selectValues()
+	t.updateViewportSize()
+	return t
+}
+
+// Children sets the func loading the children of a node. It's called once per
+// node, the first time the node is expanded. Nodes without children are
+// leaves.
+func (t *TreeSelect[T]) Children(children func(parent T) []Option[T]) *TreeSelect[T] {
+	t.children = children
+	return t
+}
+
+// Cascade sets whether checking a node checks all of its descendants, and
+// whether a node is checked when all of its children are. Only applies when
+// selecting multiple nodes.
+func (t *TreeSelect[T]) Cascade(cascade bool) *TreeSelect[T] {
+	t.cascade = cascade
+	return t
+}
+
+// Height sets the height of the tree select field. If the nodes don't fit,
+// they're scrollable.
+func (t *TreeSelect[T]) Height(height int) *TreeSelect[T] {
+	t.height = height
+	t.updateViewportSize()
+	return t
+}
+
+// Validate sets the validation function of the tree select field when
+// selecting a single node.
+func (t *TreeSelect[T]) Validate(validate func(T) error) *TreeSelect[T] {
+	t.validate = validate
+	return t
+}
+
+// ValidateValues sets the validation function of the tree select field when
+// selecting multiple nodes.
+func (t *TreeSelect[T]) ValidateValues(validate func([]T) error) *TreeSelect[T] {
+	t.validateMulti = validate
+	return t
+}
+
+// Error returns the error of the tree select field.
+func (t *TreeSelect[T]) Error() error { return t.err }
+
+// Skip returns whether the tree select field should be skipped or should be
+// blocking.
+func (t *TreeSelect[T]) Skip() bool { return t.hidden() }
+
+// Hide sets whether the tree select field is hidden.
+func (t *TreeSelect[T]) Hide(hide bool) *TreeSelect[T] {
+	return t.HideFunc(func() bool { return hide })
+}
+
+// HideFunc sets the function that checks whether the tree select field is
+// hidden.
+//
+// Hidden fields are skipped, aren't rendered or validated, and are left out of
+// the form's results.
+func (t *TreeSelect[T]) HideFunc(hideFunc func() bool) *TreeSelect[T] {
+	t.hide = hideFunc
+	return t
+}
+
+// hidden returns whether the tree select field is hidden.
+func (t *TreeSelect[T]) hidden() bool { return t.hide != nil && t.hide() }
+
+// Zoom returns whether the tree select field should be zoomed.
+func (*TreeSelect[T]) Zoom() bool { return false }
+
+// Focus focuses the tree select field.
+func (t *TreeSelect[T]) Focus() tea.Cmd {
+	t.focused = true
+	return nil
+}
+
+// Blur blurs the tree select field.
+func (t *TreeSelect[T]) Blur() tea.Cmd {
+	t.focused = false
+	t.err = t.check()
+	return nil
+}
+
+// Hovered returns the value of the node under the cursor, and a bool
+// indicating whether one was found.
+func (t *TreeSelect[T]) Hovered() (T, bool) {
+	if t.cursor >= len(t.visible) {
+		var zero T
+		return zero, false
+	}
+	return t.visible[t.cursor].option.Value, true
+}
+
+// KeyBinds returns the help keybindings for the tree select field.
+func (t *TreeSelect[T]) KeyBinds() []key.Binding {
+	return []key.Binding{
+		t.keymap.Toggle,
+		t.keymap.Up,
+		t.keymap.Down,
+		t.keymap.Expand,
+		t.keymap.Collapse,
+		t.keymap.Filter,
+		t.keymap.SetFilter,
+		t.keymap.ClearFilter,
+		t.keymap.Prev,
+		t.keymap.Next,
+		t.keymap.Submit,
+	}
+}
+
+// Init initializes the tree select field.
+func (t *TreeSelect[T]) Init() tea.Cmd {
+	return nil
+}
+
+// Update updates the tree select field.
+func (t *TreeSelect[T]) Update(msg tea.Msg) (Model, tea.Cmd) {
+	t.updateViewportSize()
+
+	var cmd tea.Cmd
+	filterBefore := t.filter.Value()
+	if t.filtering {
+		t.filter, cmd = t.filter.Update(msg)
+	}
+
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		t.hasDarkBg = msg.IsDark()
+	case updateFieldMsg:
+		var cmds []tea.Cmd
+		if ok, hash := t.title.shouldUpdate(); ok {
+			t.title.bindingsHash = hash
+			if !t.title.loadFromCache() {
+				t.title.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateTitleMsg{id: t.id, title: t.title.fn(), hash: hash}
+				})
+			}
+		}
+		if ok, hash := t.description.shouldUpdate(); ok {
+			t.description.bindingsHash = hash
+			if !t.description.loadFromCache() {
+				t.description.loading = true
+				cmds = append(cmds, func() tea.Msg {
+					return updateDescriptionMsg{id: t.id, description: t.description.fn(), hash: hash}
+				})
+			}
+		}
+		return t, tea.Batch(cmds...)
+	case updateTitleMsg:
+		if msg.id == t.id && msg.hash == t.title.bindingsHash {
+			t.title.update(msg.title)
+		}
+	case updateDescriptionMsg:
+		if msg.id == t.id && msg.hash == t.description.bindingsHash {
+			t.description.update(msg.description)
+		}
+	case tea.KeyPressMsg:
+		t.err = nil
+
+		// While filtering, text goes to the filter so only the up and down
+		// bindings that don't type anything move the cursor.
+		if t.filtering {
+			switch {
+			case key.Matches(msg, t.keymap.SetFilter):
+				if len(t.visible) == 0 {
+					t.filter.SetValue("")
+				}
+				t.setFiltering(false)
+			case msg.Text == "" && key.Matches(msg, t.keymap.Up):
+				t.moveCursor(-1)
+			case msg.Text == "" && key.Matches(msg, t.keymap.Down):
+				t.moveCursor(1)
+			}
+			if t.filter.Value() != filterBefore {
+				t.updateVisible(nil)
+				t.cursor = 0
+				t.viewport.GotoTop()
+				t.updateValue()
+			}
+			return t, cmd
+		}
+
+		switch {
+		case key.Matches(msg, t.keymap.Filter):
+			t.setFiltering(true)
+			return t, t.filter.Focus()
+		case key.Matches(msg, t.keymap.ClearFilter):
+			t.clearFilter()
+		case key.Matches(msg, t.keymap.Up):
+			t.moveCursor(-1)
+		case key.Matches(msg, t.keymap.Down):
+			t.moveCursor(1)
+		case key.Matches(msg, t.keymap.GotoTop):
+			t.moveCursor(-len(t.visible))
+		case key.Matches(msg, t.keymap.GotoBottom):
+			t.moveCursor(len(t.visible))
+		case key.Matches(msg, t.keymap.Expand):
+			t.expand()
+		case key.Matches(msg, t.keymap.Collapse):
+			t.collapse()
+		case key.Matches(msg, t.keymap.Toggle):
+			if t.cursor < len(t.visible) {
+				node := t.visible[t.cursor]
+				t.setChecked(node, !node.option.selected)
+				t.updateValue()
+			}
+		case key.Matches(msg, t.keymap.Prev):
+			return t, PrevField
+		case key.Matches(msg, t.keymap.Next, t.keymap.Submit):
+			if t.err = t.check(); t.err != nil {
+				return t, nil
+			}
+			return t, NextField
+		}
+	}
+
+	return t, cmd
+}
+
+// moveCursor moves the cursor by the given number of nodes, and updates the
+// value when selecting a single node.
+func (t *TreeSelect[T]) moveCursor(delta int) {
+	t.cursor = max(min(t.cursor+delta, len(t.visible)-1), 0)
+	ensureVisible(&t.viewport, t.cursor, 1)
+	t.updateValue()
+}
+
+// expand expands the node under the cursor, or moves to its first child if
+// it's already expanded.
+func (t *TreeSelect[T]) expand() {
+	if t.cursor >= len(t.visible) {
+		return
+	}
+	node := t.visible[t.cursor]
+	t.load(node)
+	if len(node.children) == 0 {
+		return
+	}
+	if node.expanded {
+		t.moveCursor(1)
+		return
+	}
+	node.expanded = true
+	t.updateVisible(node)
+}
+
+// collapse collapses the node under the cursor, or moves to its parent if it's
+// already collapsed.
+func (t *TreeSelect[T]) collapse() {
+	if t.cursor >= len(t.visible) {
+		return
+	}
+	node := t.visible[t.cursor]
+	if node.expanded {
+		node.expanded = false
+		t.updateVisible(node)
+		return
+	}
+	if node.parent != nil {
+		t.updateVisible(node.parent)
+		t.updateValue()
+	}
+}
+
+// newNodes returns the nodes of the given options.
+func (t *TreeSelect[T]) newNodes(parent *treeNode[T], options []Option[T]) []*treeNode[T] {
+	nodes := make([]*treeNode[T], len(options))
+	for i, option := range options {
+		nodes[i] = &treeNode[T]{option: option, parent: parent}
+		if parent != nil {
+			nodes[i].depth = parent.depth + 1
+			nodes[i].option.selected = option.selected || (t.cascade && parent.option.selected)
+		}
+		if t.multiple && slices.Contains(t.multiAccessor.Get(), option.Value) {
+			nodes[i].option.selected = true
+		}
+	}
+	return nodes
+}
+
+// load loads the children of a node, once.
+func (t *TreeSelect[T]) load(node *treeNode[T]) {
+	if node.loaded {
+		return
+	}
+	node.loaded = true
+	if t.children != nil {
+		node.children = t.newNodes(node, t.children(node.option.Value))
+	}
+}
+
+// loadAll loads every node of the tree.
+func (t *TreeSelect[T]) loadAll() {
+	var visit func(nodes []*treeNode[T])
+	visit = func(nodes []*treeNode[T]) {
+		for _, node := range nodes {
+			t.load(node)
+			visit(node.children)
+		}
+	}
+	visit(t.roots)
+}
+
+// walk calls fn on every loaded node, parents first.
+func (t *TreeSelect[T]) walk(fn func(node *treeNode[T])) {
+	var visit func(nodes []*treeNode[T])
+	visit = func(nodes []*treeNode[T]) {
+		for _, node := range nodes {
+			fn(node)
+			visit(node.children)
+		}
+	}
+	visit(t.roots)
+}
+
+// expandable returns whether a node has, or may have, children.
+func (t *TreeSelect[T]) expandable(node *treeNode[T]) bool {
+	if node.loaded {
+		return len(node.children) > 0
+	}
+	return t.children != nil
+}
+
+// setChecked checks or unchecks a node and, when cascading, its descendants
+// and ancestors.
+func (t *TreeSelect[T]) setChecked(node *treeNode[T], checked bool) {
+	node.option.selected = checked
+	if !t.cascade {
+		return
+	}
+	var down func(n *treeNode[T])
+	down = func(n *treeNode[T]) {
+		for _, child := range n.children {
+			child.option.selected = checked
+			down(child)
+		}
+	}
+	down(node)
+	for p := node.parent; p != nil; p = p.parent {
+		p.option.selected = !slices.ContainsFunc(p.children, func(c *treeNode[T]) bool { return !c.option.selected })
+	}
+}
+
+// updateVisible lists the visible nodes, and puts the cursor on the given
+// node if it's visible.
+func (t *TreeSelect[T]) updateVisible(cursor *treeNode[T]) {
+	filter := strings.ToLower(t.filter.Value())
+	var visit func(nodes []*treeNode[T]) []*treeNode[T]
+	visit = func(nodes []*treeNode[T]) []*treeNode[T] {
+		var visible []*treeNode[T]
+		for _, node := range nodes {
+			if filter == "" {
+				visible = append(visible, node)
+				if node.expanded {
+					visible = append(visible, visit(node.children)...)
+				}
+				continue
+			}
+			// Keep the ancestors of the matching nodes.
+			descendants := visit(node.children)
+			if len(descendants) > 0 || strings.Contains(strings.ToLower(node.option.Key), filter) {
+				visible = append(visible, node)
+				visible = append(visible, descendants...)
+			}
+		}
+		return visible
+	}
+	t.visible = visit(t.roots)
+	if i := slices.Index(t.visible, cursor); i >= 0 {
+		t.cursor = i
+	}
+	t.cursor = max(min(t.cursor, len(t.visible)-1), 0)
+	ensureVisible(&t.viewport, t.cursor, 1)
+}
+
+// clearFilter clears the value of the filter.
+func (t *TreeSelect[T]) clearFilter() {
+	var hovered *treeNode[T]
+	if t.cursor < len(t.visible) {
+		hovered = t.visible[t.cursor]
+	}
+	t.filter.SetValue("")
+	t.setFiltering(false)
+
+	// Expand the ancestors of the hovered node so it stays visible.
+	if hovered != nil {
+		for p := hovered.parent; p != nil; p = p.parent {
+			p.expanded = true
+		}
+	}
+	t.updateVisible(hovered)
+}
+
+// setFiltering sets the filter of the tree select field.
+func (t *TreeSelect[T]) setFiltering(filtering bool) {
+	t.filtering = filtering
+	t.keymap.SetFilter.SetEnabled(filtering)
+	t.keymap.Filter.SetEnabled(!filtering)
+	t.keymap.ClearFilter.SetEnabled(!filtering && t.filter.Value() != "")
+}
+
+// selectValues checks the nodes holding the value and, when selecting a
+// single node, moves the cursor to it.
+func (t *TreeSelect[T]) selectValues() {
+	var cursor *treeNode[T]
+	t.walk(func(node *treeNode[T]) {
+		if t.multiple {
+			node.option.selected = node.option.selected || slices.Contains(t.multiAccessor.Get(), node.option.Value)
+		} else if cursor == nil && node.option.Value == t.accessor.Get() {
+			cursor = node
+		}
+	})
+	if cursor != nil {
+		for p := cursor.parent; p != nil; p = p.parent {
+			p.expanded = true
+		}
+	}
+	t.updateVisible(cursor)
+	t.updateValue()
+}
+
+// updateValue sets the value from the node under the cursor when selecting a
+// single node, or from the checked nodes.
+func (t *TreeSelect[T]) updateValue() {
+	if !t.multiple {
+		if value, ok := t.Hovered(); ok {
+			t.accessor.Set(value)
+		}
+		return
+	}
+	t.multiAccessor.Set(t.checked())
+}
+
+// checked returns the values of the checked nodes, in tree order.
+func (t *TreeSelect[T]) checked() []T {
+	values := make([]T, 0)
+	t.walk(func(node *treeNode[T]) {
+		if node.option.selected {
+			values = append(values, node.option.Value)
+		}
+	})
+	return values
+}
+
+// check checks the value with its validation function.
+func (t *TreeSelect[T]) check() error {
+	if t.multiple {
+		return t.validateMulti(t.multiAccessor.Get())
+	}
+	if len(t.roots) == 0 {
+		return errors.New("no option selected")
+	}
+	return t.validate(t.accessor.Get())
+}
+
+// updateViewportSize updates the viewport size according to the Height setting
+// on this tree select field.
+func (t *TreeSelect[T]) updateViewportSize() {
+	if t.height > 0 {
+		yoffset := 0
+		if ss := t.titleView(); ss != "" {
+			yoffset += lipgloss.Height(ss)
+		}
+		if t.description.val != "" || t.description.fn != nil {
+			yoffset += lipgloss.Height(t.descriptionView())
+		}
+		t.viewport.SetHeight(max(minHeight, t.height-yoffset))
+	} else {
+		t.viewport.SetHeight(max(len(t.visible), minHeight))
+	}
+	if t.width > 0 {
+		t.viewport.SetWidth(t.width)
+	} else {
+		t.viewport.SetWidth(lipgloss.Width(t.nodesView()))
+	}
+	ensureVisible(&t.viewport, t.cursor, 1)
+}
+
+func (t *TreeSelect[T]) activeStyles() *FieldStyles {
+	theme := t.theme
+	if theme == nil {
+		theme = ThemeFunc(ThemeCharm)
+	}
+	if t.focused {
+		return &theme.Theme(t.hasDarkBg).Focused
+	}
+	return &theme.Theme(t.hasDarkBg).Blurred
+}
+
+func (t *TreeSelect[T]) titleView() string {
+	var (
+		styles   = t.activeStyles()
+		sb       = strings.Builder{}
+		maxWidth = t.width - styles.Base.GetHorizontalFrameSize()
+	)
+	if t.filtering {
+		sb.WriteString(t.filter.View())
+	} else if t.filter.Value() != "" {
+		sb.WriteString(styles.Description.Render("/" + t.filter.Value()))
+	} else {
+		sb.WriteString(styles.Title.Render(wrap(t.title.val, maxWidth)))
+	}
+	if t.err != nil {
+		sb.WriteString(styles.ErrorIndicator.String())
+	}
+	return sb.String()
+}
+
+func (t *TreeSelect[T]) descriptionView() string {
+	maxWidth := t.width - t.activeStyles().Base.GetHorizontalFrameSize()
+	return t.activeStyles().Description.Render(wrap(t.description.val, maxWidth))
+}
+
+func (t *TreeSelect[T]) nodesView() string {
+	styles := t.activeStyles()
+	if len(t.visible) == 0 {
+		return styles.TextInput.Placeholder.Render("No matches")
+	}
+
+	lines := make([]string, len(t.visible))
+	for i, node := range t.visible {
+		current := t.focused && i == t.cursor
+		var sb strings.Builder
+		selector := styles.SelectSelector.String()
+		if t.multiple {
+			selector = styles.MultiSelectSelector.String()
+		}
+		if current {
+			sb.WriteString(selector)
+		} else {
+			sb.WriteString(strings.Repeat(" ", lipgloss.Width(selector)))
+		}
+		sb.WriteString(strings.Repeat(treeIndent, node.depth))
+		switch {
+		case !t.expandable(node):
+			sb.WriteString(treeLeaf)
+		case node.expanded || (t.filter.Value() != "" && len(node.children) > 0):
+			sb.WriteString(treeExpanded)
+		default:
+			sb.WriteString(treeCollapsed)
+		}
+		if t.multiple {
+			if node.option.selected {
+				sb.WriteString(styles.SelectedPrefix.String())
+			} else {
+				sb.WriteString(styles.UnselectedPrefix.String())
+			}
+		}
+		if current || (t.multiple && node.option.selected) {
+			sb.WriteString(styles.SelectedOption.Render(node.option.Key))
+		} else {
+			sb.WriteString(styles.UnselectedOption.Render(node.option.Key))
+		}
+		lines[i] = sb.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// View renders the tree select field.
+func (t *TreeSelect[T]) View() string {
+	styles := t.activeStyles()
+	t.viewport.SetContent(t.nodesView())
+
+	var parts []string
+	if t.title.val != "" || t.title.fn != nil || t.filtering || t.filter.Value() != "" {
+		parts = append(parts, t.titleView())
+	}
+	if t.description.val != "" || t.description.fn != nil {
+		parts = append(parts, t.descriptionView())
+	}
+	parts = append(parts, t.viewport.View())
+	return styles.Base.Width(t.width).Height(t.height).
+		Render(strings.Join(parts, "\n"))
+}
+
+// Run runs the tree select field.
+func (t *TreeSelect[T]) Run() error {
+	return Run(t)
+}
+
+// nodes returns every node of the tree, parents first.
+func (t *TreeSelect[T]) nodes() []*treeNode[T] {
+	var nodes []*treeNode[T]
+	t.walk(func(node *treeNode[T]) { nodes = append(nodes, node) })
+	return nodes
+}
+
+// printNodes prints the numbered nodes with their path.
+func (t *TreeSelect[T]) printNodes(w io.Writer, nodes []*treeNode[T]) {
+	styles := t.activeStyles()
+	var sb strings.Builder
+	for i, node := range nodes {
+		path := strings.Join(node.path(), " / ")
+		switch {
+		case !t.multiple:
+			_, _ = fmt.Fprintf(&sb, "%d. %s", i+1, path)
+		case node.option.selected:
+			sb.WriteString(styles.SelectedOption.Render(fmt.Sprintf("%d. %s %s", i+1, "✓", path)))
+		default:
+			_, _ = fmt.Fprintf(&sb, "%d.   %s", i+1, path)
+		}
+		sb.WriteString("\n")
+	}
+	if t.multiple {
+		sb.WriteString("0.   Confirm selection\n")
+	}
+	_, _ = fmt.Fprint(w, sb.String())
+}
+
+// RunAccessible runs the tree select field in accessible mode.
+//
+// Every node is loaded, and listed with the path to it.
+func (t *TreeSelect[T]) RunAccessible(w io.Writer, r io.Reader) error {
+	styles := t.activeStyles()
+	_, _ = fmt.Fprintln(w, styles.Title.
+		PaddingRight(1).
+		Render(cmp.Or(t.title.val, "Select:")))
+
+	t.loadAll()
+	nodes := t.nodes()
+	if len(nodes) == 0 {
+		return errors.New("no options to select from")
+	}
+
+	if !t.multiple {
+		t.printNodes(w, nodes)
+		var defaultValue *int
+		if _, ok := t.accessor.(*PointerAccessor[T]); ok {
+			if i := slices.IndexFunc(nodes, func(n *treeNode[T]) bool { return n.option.Value == t.accessor.Get() }); i >= 0 {
+				defaultValue = new(int)
+				*defaultValue = i + 1
+			}
+		}
+		prompt := fmt.Sprintf("Enter a number between %d and %d: ", 1, len(nodes))
+		for {
+			choice := accessibility.PromptInt(w, r, prompt, 1, len(nodes), defaultValue)
+			value := nodes[choice-1].option.Value
+			if err := t.validate(value); err != nil {
+				_, _ = fmt.Fprintln(w, err.Error())
+				_, _ = fmt.Fprintln(w)
+				continue
+			}
+			t.accessor.Set(value)
+			return nil
+		}
+	}
+
+	for {
+		t.printNodes(w, nodes)
+		prompt := fmt.Sprintf("Enter a number between %d and %d: ", 0, len(nodes))
+		choice := accessibility.PromptInt(w, r, prompt, 0, len(nodes), nil)
+		if choice <= 0 {
+			t.updateValue()
+			if err := t.validateMulti(t.multiAccessor.Get()); err != nil {
+				_, _ = fmt.Fprintln(w, err)
+				continue
+			}
+			return nil
+		}
+		node := nodes[choice-1]
+		t.setChecked(node, !node.option.selected)
+		_, _ = fmt.Fprintln(w)
+	}
+}
+
+// answer sets the value of the tree select field from an answers source.
+//
+// Nodes are answered by value, key, or value formatted as a string. A string
+// is treated as a comma separated list when selecting multiple nodes.
+func (t *TreeSelect[T]) answer(value any) error {
+	t.loadAll()
+	options := make([]Option[T], 0)
+	t.walk(func(node *treeNode[T]) { options = append(options, node.option) })
+
+	if !t.multiple {
+		option, err := answerOption(options, value)
+		if err != nil {
+			return err
+		}
+		t.accessor.Set(option.Value)
+		t.selectValues()
+		return t.check()
+	}
+
+	values := make([]T, 0)
+	for _, item := range answerList(value) {
+		option, err := answerOption(options, item)
+		if err != nil {
+			return err
+		}
+		values = append(values, option.Value)
+	}
+	t.walk(func(node *treeNode[T]) { node.option.selected = false })
+	t.multiAccessor.Set(values)
+	t.selectValues()
+	return t.check()
+}
+
+// review returns the title and value shown on the form's review page.
+func (t *TreeSelect[T]) review() (string, string) {
+	var keys []string
+	t.walk(func(node *treeNode[T]) {
+		if (t.multiple && node.option.selected) || (!t.multiple && len(keys) == 0 && node.option.Value == t.accessor.Get()) {
+			keys = append(keys, node.option.Key)
+		}
+	})
+	if !t.multiple && len(keys) == 0 {
+		return t.title.val, fmt.Sprint(t.accessor.Get())
+	}
+	return t.title.val, strings.Join(keys, ", ")
+}
+
+// WithTheme sets the theme of the tree select field.
+func (t *TreeSelect[T]) WithTheme(theme Theme) Field {
+	if t.theme != nil {
+		return t
+	}
+	t.theme = theme
+	styles := t.theme.Theme(t.hasDarkBg)
+
+	st := t.filter.Styles()
+	st.Cursor.Color = styles.Focused.TextInput.Cursor.GetForeground()
+	st.Focused.Prompt = styles.Focused.TextInput.Prompt
+	st.Focused.Text = styles.Focused.TextInput.Text
+	st.Focused.Placeholder = styles.Focused.TextInput.Placeholder
+	t.filter.SetStyles(st)
+
+	t.updateViewportSize()
+	return t
+}
+
+// WithKeyMap sets the keymap on a tree select field.
+func (t *TreeSelect[T]) WithKeyMap(k *KeyMap) Field {
+	t.keymap = k.TreeSelect
+	t.keymap.Toggle.SetEnabled(t.multiple)
+	return t
+}
+
+// WithWidth sets the width of the tree select field.
+func (t *TreeSelect[T]) WithWidth(width int) Field {
+	t.width = width
+	t.updateViewportSize()
+	return t
+}
+
+// WithHeight sets the height of the tree select field.
+func (t *TreeSelect[T]) WithHeight(height int) Field {
+	return t.Height(height)
+}
+
+// WithPosition sets the position of the tree select field.
+func (t *TreeSelect[T]) WithPosition(p FieldPosition) Field {
+	if t.filtering {
+		return t
+	}
+	t.keymap.Prev.SetEnabled(!p.IsFirst())
+	t.keymap.Next.SetEnabled(!p.IsLast())
+	t.keymap.Submit.SetEnabled(p.IsLast())
+	return t
+}
+
+// GetKey returns the key of the field.
+func (t *TreeSelect[T]) GetKey() string { return t.key }
+
+// GetValue returns the value of the field: a single value, or a slice of
+// values when selecting multiple nodes.
+func (t *TreeSelect[T]) GetValue() any {
+	if t.multiple {
+		return t.multiAccessor.Get()
+	}
+	return t.accessor.Get()
+}
+
+// GetFiltering returns the filtering state of the field.
+func (t *TreeSelect[T]) GetFiltering() bool {
+	return t.filtering
+}
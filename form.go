@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -96,6 +98,31 @@ type Form struct {
 
 	layout Layout
 
+	// answers used instead of prompting, if any
+	answers Answers
+
+	// where drafts are saved to, if any
+	draft DraftStore
+
+	// last window height, and which fields were visible when the groups'
+	// heights were last fitted.
+	windowHeight int
+	visibility   string
+
+	// indexes of the groups the user went through, to go back along the
+	// same path.
+	history []int
+
+	// review page
+	review       bool
+	reviewing    bool
+	reviewed     bool
+	reviewCursor int
+
+	// groups listed on the review page, to go back to it once an edit
+	// reaches one of them.
+	reviewedGroups map[int]bool
+
 	// accessible mode IO
 	output io.Writer
 	input  io.Reader
@@ -125,6 +152,7 @@ func NewForm(groups ...*Group) *Form {
 	f.WithWidth(f.width)
 	f.WithHeight(f.height)
 	f.UpdateFieldPositions()
+	f.visibility = f.fieldVisibility()
 
 	if os.Getenv("TERM") == "dumb" {
 		f.WithWidth(defaultWidth)
@@ -367,6 +395,42 @@ func (f *Form) WithLayout(layout Layout) *Form {
 	return f
 }
 
+// WithAnswers sets a source of answers used to complete the form without
+// prompting, which is useful when no terminal is available such as in CI.
+//
+// When set, running the form fills each field from the answer matching its
+// key and validates it with the field's validation function, without starting
+// a Bubble Tea program. Every field that isn't skipped needs an answer. If any
+// answers are missing or invalid, an [*AnswersError] listing all of them is
+// returned.
+func (f *Form) WithAnswers(answers Answers) *Form {
+	f.answers = answers
+	return f
+}
+
+// WithDraft sets where the form saves drafts of its answers, so that it can
+// be resumed after being aborted.
+//
+// A draft is saved each time a field is completed. When a draft exists, Run
+// offers to resume from it, pre-filling the fields and going back to where
+// the user left off. The draft is deleted once the form is submitted.
+func (f *Form) WithDraft(store DraftStore) *Form {
+	f.draft = store
+	return f
+}
+
+// WithReview sets whether the form shows a review page after the last group.
+//
+// The review page lists the title and value of every answered field, along
+// the groups the user went through. From there the user can jump back to a
+// field to edit it, and submit or cancel the form. After an edit, completing
+// the group returns to the review page, once the groups the edit led to are
+// completed too.
+func (f *Form) WithReview(v bool) *Form {
+	f.review = v
+	return f
+}
+
 // UpdateFieldPositions sets the position on all the fields.
 func (f *Form) UpdateFieldPositions() *Form {
 	firstGroup := 0
@@ -539,7 +603,7 @@ func (f *Form) Init() tea.Cmd {
 
 	var cmds []tea.Cmd
 	f.selector.Range(func(i int, group *Group) bool {
-		if i == 0 {
+		if i == f.selector.Index() {
 			group.active = true
 		}
 		cmds = append(cmds, group.Init())
@@ -568,6 +632,7 @@ func (f *Form) Update(msg tea.Msg) (Model, tea.Cmd) {
 	case tea.BackgroundColorMsg:
 		f.hasDarkBg = msg.IsDark()
 	case tea.WindowSizeMsg:
+		f.windowHeight = msg.Height
 		if f.width == 0 {
 			f.selector.Range(func(_ int, group *Group) bool {
 				width := f.layout.GroupWidth(f, group, msg.Width)
@@ -576,18 +641,7 @@ func (f *Form) Update(msg tea.Msg) (Model, tea.Cmd) {
 			})
 		}
 		if f.height == 0 {
-			// calculate the needed height, which is the height of the
-			// heightest group, accounting for the width, wraps, etc.
-			neededHeight := 0
-			f.selector.Range(func(_ int, group *Group) bool {
-				neededHeight = max(neededHeight, group.rawHeight())
-				return true
-			})
-
-			f.selector.Range(func(_ int, group *Group) bool {
-				group.WithHeight(min(neededHeight, msg.Height))
-				return true
-			})
+			f.fitGroupHeights()
 		}
 
 	case tea.KeyPressMsg:
@@ -598,6 +652,9 @@ func (f *Form) Update(msg tea.Msg) (Model, tea.Cmd) {
 			f.State = StateAborted
 			return f, f.CancelCmd
 		}
+		if f.reviewing {
+			return f, f.updateReview(msg)
+		}
 
 	case nextFieldMsg:
 		// Form is progressing to the next field, let's save the value of the current field.
@@ -605,6 +662,8 @@ func (f *Form) Update(msg tea.Msg) (Model, tea.Cmd) {
 			field := group.selector.Selected()
 			f.results[field.GetKey()] = field.GetValue()
 		}
+		// and save a draft, once the group has moved on to the next field.
+		defer f.saveDraft()
 
 	case nextGroupMsg:
 		if len(group.Errors()) > 0 {
@@ -612,27 +671,29 @@ func (f *Form) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 
 		submit := func() (Model, tea.Cmd) {
+			if f.review {
+				f.startReview()
+				return f, nil
+			}
+			f.dropHiddenResults()
 			f.quitting = true
 			f.State = StateCompleted
 			return f, f.SubmitCmd
 		}
 
-		if f.selector.OnLast() {
+		// the last group may still lead to another one, so only submit
+		// when there's no next group, all subsequent groups are hidden, or
+		// the next one was already gone through, which ends the path.
+		// after editing a field from the review page, the groups the edit
+		// made reachable are gone through before going back to it.
+		next := f.nextGroupIndex(f.selector.Index())
+		if next < 0 || next == f.selector.Index() || slices.Contains(f.history, next) || f.reviewedGroups[next] {
 			return submit()
 		}
-
-		for i := f.selector.Index() + 1; i < f.selector.Total(); i++ {
-			if !f.isGroupHidden(f.selector.Get(i)) {
-				f.selector.SetIndex(i)
-				break
-			}
-			// all subsequent groups are hidden, so we must act as
-			// if we were in the last one.
-			if i == f.selector.Total()-1 {
-				return submit()
-			}
-		}
+		f.history = append(f.history, f.selector.Index())
+		f.selector.SetIndex(next)
 		f.selector.Selected().active = true
+		f.saveDraft()
 		return f, f.selector.Selected().Init()
 
 	case prevGroupMsg:
@@ -640,10 +701,14 @@ func (f *Form) Update(msg tea.Msg) (Model, tea.Cmd) {
 			return f, nil
 		}
 
-		for i := f.selector.Index() - 1; i >= 0; i-- {
-			if !f.isGroupHidden(f.selector.Get(i)) {
-				f.selector.SetIndex(i)
-				break
+		if prev := f.prevGroupIndex(); prev >= 0 {
+			f.selector.SetIndex(prev)
+		} else {
+			for i := f.selector.Index() - 1; i >= 0; i-- {
+				if !f.isGroupHidden(f.selector.Get(i)) {
+					f.selector.SetIndex(i)
+					break
+				}
 			}
 		}
 
@@ -659,6 +724,17 @@ func (f *Form) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg.(type) {
 	case tea.KeyPressMsg:
 		f.UpdateFieldPositions()
+
+		// fields may have been hidden or shown too, or grown, such as lists
+		// with a new item, in which case the groups need to be fitted again.
+		visibility := f.fieldVisibility()
+		grown := group.rawHeight() > group.height && (f.windowHeight == 0 || group.height < f.windowHeight)
+		if visibility != f.visibility || grown {
+			f.visibility = visibility
+			if f.height == 0 {
+				f.fitGroupHeights()
+			}
+		}
 	}
 
 	return f, cmd
@@ -677,6 +753,129 @@ func (f *Form) isGroupHidden(group *Group) bool {
 	return hide()
 }
 
+// fitGroupHeights sets the height of all groups to the needed height, which
+// is the height of the heightest group, accounting for the width, wraps,
+// hidden fields, etc., within the window's height.
+func (f *Form) fitGroupHeights() {
+	neededHeight := 0
+	f.selector.Range(func(_ int, group *Group) bool {
+		neededHeight = max(neededHeight, group.rawHeight())
+		return true
+	})
+	if f.windowHeight > 0 {
+		neededHeight = min(neededHeight, f.windowHeight)
+	}
+
+	f.selector.Range(func(_ int, group *Group) bool {
+		group.WithHeight(neededHeight)
+		group.buildView()
+		return true
+	})
+}
+
+// fieldVisibility returns which fields are hidden, as a string of 0s and 1s.
+func (f *Form) fieldVisibility() string {
+	var sb strings.Builder
+	f.selector.Range(func(_ int, group *Group) bool {
+		group.selector.Range(func(_ int, field Field) bool {
+			if isFieldHidden(field) {
+				sb.WriteByte('1')
+			} else {
+				sb.WriteByte('0')
+			}
+			return true
+		})
+		return true
+	})
+	return sb.String()
+}
+
+// dropHiddenResults removes the results of hidden fields.
+func (f *Form) dropHiddenResults() {
+	f.selector.Range(func(_ int, group *Group) bool {
+		group.selector.Range(func(_ int, field Field) bool {
+			if isFieldHidden(field) {
+				delete(f.results, field.GetKey())
+			}
+			return true
+		})
+		return true
+	})
+}
+
+// groupIndex returns the index of the group with the given key, or -1.
+func (f *Form) groupIndex(key string) int {
+	index := -1
+	f.selector.Range(func(i int, group *Group) bool {
+		if group.key == key {
+			index = i
+			return false
+		}
+		return true
+	})
+	return index
+}
+
+// nextGroupIndex returns the index of the group shown after the given one,
+// following the groups' Next functions and skipping hidden groups. It
+// returns -1 if the form should be submitted instead.
+func (f *Form) nextGroupIndex(i int) int {
+	// bounded, in case hidden groups lead to each other.
+	for range f.selector.Total() {
+		next := i + 1
+		if fn := f.selector.Get(i).next; fn != nil {
+			if key := fn(); key != "" {
+				next = f.groupIndex(key)
+			}
+		}
+		if next < 0 || next >= f.selector.Total() {
+			return -1
+		}
+		if !f.isGroupHidden(f.selector.Get(next)) {
+			return next
+		}
+		i = next
+	}
+	return -1
+}
+
+// firstGroupIndex returns the index of the first group shown, or -1 if
+// they're all hidden.
+func (f *Form) firstGroupIndex() int {
+	if f.selector.Empty() {
+		return -1
+	}
+	if !f.isGroupHidden(f.selector.Get(0)) {
+		return 0
+	}
+	return f.nextGroupIndex(0)
+}
+
+// prevGroupIndex pops the group the user came from off the history, or
+// returns -1 if there's none.
+func (f *Form) prevGroupIndex() int {
+	for len(f.history) > 0 {
+		i := f.history[len(f.history)-1]
+		f.history = f.history[:len(f.history)-1]
+		if !f.isGroupHidden(f.selector.Get(i)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// path returns the indexes of the groups the form goes through, from the
+// first one, given the current answers.
+func (f *Form) path() []int {
+	var path []int
+	seen := make(map[int]bool)
+	for i := f.firstGroupIndex(); i >= 0 && !seen[i]; i = f.nextGroupIndex(i) {
+		seen[i] = true
+		path = append(path, i)
+	}
+	return path
+}
+
 func (f *Form) getTheme() *Styles {
 	if f.theme != nil {
 		return f.theme.Theme(f.hasDarkBg)
@@ -694,6 +893,9 @@ func (f *Form) View() string {
 		return ""
 	}
 
+	if f.reviewing {
+		return f.styles().Base.Render(f.reviewView())
+	}
 	return f.styles().Base.Render(f.layout.View(f))
 }
 
@@ -712,14 +914,28 @@ func (f *Form) RunWithContext(ctx context.Context) error {
 		return nil
 	}
 
-	if f.accessible {
-		return f.runAccessible(
-			cmp.Or[io.Writer](f.output, os.Stdout),
-			cmp.Or[io.Reader](f.input, os.Stdin),
-		)
+	if f.answers != nil {
+		return f.runAnswers()
 	}
 
-	return f.run(ctx)
+	w := cmp.Or[io.Writer](f.output, os.Stdout)
+	r := cmp.Or[io.Reader](f.input, os.Stdin)
+	if f.draft != nil {
+		if err := f.resumeDraft(w, r); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if f.accessible {
+		err = f.runAccessible(w, r)
+	} else {
+		err = f.run(ctx)
+	}
+	if err == nil && f.draft != nil && f.State == StateCompleted {
+		return f.draft.Delete() //nolint:wrapcheck
+	}
+	return err
 }
 
 // run runs the form in normal mode.
@@ -763,16 +979,47 @@ func (f *Form) runAccessible(w io.Writer, r io.Reader) error {
 		return ErrTimeoutUnsupported
 	}
 
-	f.selector.Range(func(_ int, group *Group) bool {
-		group.selector.Range(func(_ int, field Field) bool {
-			field.Init()
-			field.Focus()
-			_ = field.RunAccessible(w, r)
-			_, _ = fmt.Fprintln(w)
+	// groups are chosen as the fields are answered, following the same
+	// path as in the interactive mode. a resumed draft starts from its
+	// group.
+	start := f.selector.Index()
+	if f.isGroupHidden(f.selector.Selected()) {
+		start = f.nextGroupIndex(start)
+	}
+	seen := make(map[int]bool)
+	for i := start; i >= 0 && !seen[i]; i = f.nextGroupIndex(i) {
+		seen[i] = true
+		f.runGroupAccessible(i, w, r)
+	}
+	f.State = StateCompleted
+
+	if f.review {
+		return f.runReviewAccessible(w, r, seen)
+	}
+	return nil
+}
+
+// runGroupAccessible prompts for the visible fields of a group.
+func (f *Form) runGroupAccessible(i int, w io.Writer, r io.Reader) {
+	f.selector.SetIndex(i)
+	group := f.selector.Selected()
+	group.selector.Range(func(j int, field Field) bool {
+		if isFieldHidden(field) {
 			return true
-		})
+		}
+		f.runFieldAccessible(group, j, w, r)
 		return true
 	})
+}
 
-	return nil
+// runFieldAccessible prompts for a field of a group and saves its value.
+func (f *Form) runFieldAccessible(group *Group, j int, w io.Writer, r io.Reader) {
+	field := group.selector.Get(j)
+	field.Init()
+	field.Focus()
+	_ = field.RunAccessible(w, r)
+	_, _ = fmt.Fprintln(w)
+	f.results[field.GetKey()] = field.GetValue()
+	group.selector.SetIndex(j)
+	f.saveDraft()
 }
@@ -0,0 +1,409 @@
+package huh
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// structTagName is the name of the struct tag read by [FormFromStruct].
+const structTagName = "huh"
+
+// FormFromStruct returns a new form built from the exported fields of the
+// struct pointed to by v. Each field's value is bound to the struct field, so
+// the struct is populated as the form is completed.
+//
+// Fields are mapped to form fields based on their type:
+//
+//   - string becomes an Input, a Select if it has options, or a Text if it is
+//     tagged with text.
+//   - bool becomes a Confirm.
+//   - []string becomes a MultiSelect and requires options.
+//   - nested structs become their own Group, unless they have no exported
+//     fields, such as time.Time, which isn't supported. Groups left without
+//     any fields are skipped.
+//
+// Fields are configured with the `huh` struct tag, which is a comma separated
+// list of settings. Values containing commas can be wrapped in single quotes.
+//
+//	type Config struct {
+//		Name     string   `huh:"title=Name,validate=notempty|max:32"`
+//		Shell    string   `huh:"title=Shell,options=bash|zsh|fish"`
+//		Token    string   `huh:"title=Token,password"`
+//		Features []string `huh:"title=Features,options=a|b|c,group=Extras"`
+//		Ignored  string   `huh:"-"`
+//	}
+//
+// The supported settings are key, title, description, placeholder, group,
+// options, validate (notempty, min:N and max:N, separated by |), password,
+// inline and text.
+//
+// An error is returned if v is not a pointer to a struct, if a tag is
+// malformed, or if a field has an unsupported type.
+func FormFromStruct(v any) (*Form, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("huh: FormFromStruct expects a non-nil pointer to a struct, got %T", v)
+	}
+
+	b := &structFormBuilder{groups: make(map[string]*structGroup)}
+	if err := b.walk(rv.Elem(), "", ""); err != nil {
+		return nil, err
+	}
+
+	groups := make([]*Group, 0, len(b.order))
+	for _, g := range b.order {
+		if len(g.fields) == 0 {
+			continue
+		}
+		groups = append(groups, NewGroup(g.fields...).
+			Title(g.title).
+			Description(g.description))
+	}
+	return NewForm(groups...), nil
+}
+
+// structGroup collects the fields of a group while walking a struct.
+type structGroup struct {
+	title       string
+	description string
+	fields      []Field
+}
+
+// structFormBuilder walks a struct and collects its fields into groups,
+// keeping groups in the order in which they were first seen.
+type structFormBuilder struct {
+	groups map[string]*structGroup
+	order  []*structGroup
+}
+
+func (b *structFormBuilder) group(title string) *structGroup {
+	g, ok := b.groups[title]
+	if !ok {
+		g = &structGroup{title: title}
+		b.groups[title] = g
+		b.order = append(b.order, g)
+	}
+	return g
+}
+
+func (b *structFormBuilder) walk(v reflect.Value, group, path string) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name := sf.Name
+		if path != "" {
+			name = path + "." + sf.Name
+		}
+
+		tag, err := parseStructTag(sf.Tag.Get(structTagName))
+		if err != nil {
+			return fmt.Errorf("huh: field %s: %w", name, err)
+		}
+		if tag.skip {
+			continue
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Struct {
+			if !hasExportedFields(fv.Type()) {
+				return fmt.Errorf("huh: field %s: unsupported type %s", name, fv.Type())
+			}
+			title := cmp.Or(tag.group, tag.title, sf.Name)
+			if err := b.walk(fv, title, name); err != nil {
+				return err
+			}
+			if tag.description != "" {
+				b.group(title).description = tag.description
+			}
+			continue
+		}
+
+		field, err := structField(fv, sf, tag)
+		if err != nil {
+			return fmt.Errorf("huh: field %s: %w", name, err)
+		}
+		g := b.group(cmp.Or(tag.group, group))
+		g.fields = append(g.fields, field)
+	}
+	return nil
+}
+
+// hasExportedFields returns whether the struct type has exported fields, so
+// it can be walked. Structs such as time.Time only have unexported ones.
+func hasExportedFields(t reflect.Type) bool {
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+var stringSliceType = reflect.TypeFor[[]string]()
+
+func structField(v reflect.Value, sf reflect.StructField, tag structTag) (Field, error) {
+	key := cmp.Or(tag.key, sf.Name)
+	title := cmp.Or(tag.title, sf.Name)
+
+	switch {
+	case v.Kind() == reflect.String:
+		validate, err := structStringValidator(tag.validate)
+		if err != nil {
+			return nil, err
+		}
+		accessor := structAccessor[string](v)
+		if len(tag.options) > 0 {
+			return NewSelect[string]().
+				Key(key).
+				Title(title).
+				Description(tag.description).
+				Options(NewOptions(tag.options...)...).
+				Inline(tag.inline).
+				Validate(validate).
+				Accessor(accessor), nil
+		}
+		if tag.text {
+			return NewText().
+				Key(key).
+				Title(title).
+				Description(tag.description).
+				Placeholder(tag.placeholder).
+				Validate(validate).
+				Accessor(accessor), nil
+		}
+		input := NewInput().
+			Key(key).
+			Title(title).
+			Description(tag.description).
+			Placeholder(tag.placeholder).
+			Inline(tag.inline).
+			Validate(validate).
+			Accessor(accessor)
+		if tag.password {
+			input.EchoMode(EchoModePassword)
+		}
+		return input, nil
+
+	case v.Kind() == reflect.Bool:
+		if len(tag.validate) > 0 {
+			return nil, errors.New("validate is not supported on bool fields")
+		}
+		return NewConfirm().
+			Key(key).
+			Title(title).
+			Description(tag.description).
+			Inline(tag.inline).
+			Accessor(structAccessor[bool](v)), nil
+
+	case v.Type() == stringSliceType:
+		if len(tag.options) == 0 {
+			return nil, errors.New("[]string fields require options")
+		}
+		validate, err := structSliceValidator(tag.validate)
+		if err != nil {
+			return nil, err
+		}
+		return NewMultiSelect[string]().
+			Key(key).
+			Title(title).
+			Description(tag.description).
+			Options(NewOptions(tag.options...)...).
+			Validate(validate).
+			Accessor(structAccessor[[]string](v)), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// structAccessor returns an accessor for the given addressable struct field.
+//
+// A [PointerAccessor] is used when the field's type is exactly T, otherwise
+// the value is converted, which allows named types such as `type Shell string`.
+func structAccessor[T any](v reflect.Value) Accessor[T] {
+	if p, ok := v.Addr().Interface().(*T); ok {
+		return NewPointerAccessor(p)
+	}
+	return &reflectAccessor[T]{value: v}
+}
+
+// reflectAccessor gives access to a value through reflection, converting
+// between T and the value's own type.
+type reflectAccessor[T any] struct {
+	value reflect.Value
+}
+
+// Get gets the value.
+func (a *reflectAccessor[T]) Get() T {
+	return a.value.Convert(reflect.TypeFor[T]()).Interface().(T) //nolint:forcetypeassert
+}
+
+// Set sets the value.
+func (a *reflectAccessor[T]) Set(value T) {
+	a.value.Set(reflect.ValueOf(value).Convert(a.value.Type()))
+}
+
+func structStringValidator(names []string) (func(string) error, error) {
+	validators := make([]func(string) error, 0, len(names))
+	for _, name := range names {
+		name, arg, _ := strings.Cut(name, ":")
+		switch name {
+		case "notempty":
+			validators = append(validators, ValidateNotEmpty())
+		case "min", "max":
+			n, err := strconv.Atoi(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s validator argument %q", name, arg)
+			}
+			if name == "min" {
+				validators = append(validators, ValidateMinLength(n))
+			} else {
+				validators = append(validators, ValidateMaxLength(n))
+			}
+		default:
+			return nil, fmt.Errorf("unknown validator %q", name)
+		}
+	}
+	return func(s string) error {
+		for _, validate := range validators {
+			if err := validate(s); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+func structSliceValidator(names []string) (func([]string) error, error) {
+	var minCount, maxCount int
+	for _, name := range names {
+		name, arg, _ := strings.Cut(name, ":")
+		switch name {
+		case "notempty":
+			minCount = max(minCount, 1)
+		case "min", "max":
+			n, err := strconv.Atoi(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s validator argument %q", name, arg)
+			}
+			if name == "min" {
+				minCount = n
+			} else {
+				maxCount = n
+			}
+		default:
+			return nil, fmt.Errorf("unknown validator %q", name)
+		}
+	}
+	return func(s []string) error {
+		if len(s) < minCount {
+			return fmt.Errorf("select at least %d options", minCount)
+		}
+		if maxCount > 0 && len(s) > maxCount {
+			return fmt.Errorf("select at most %d options", maxCount)
+		}
+		return nil
+	}, nil
+}
+
+// structTag is a parsed `huh` struct tag.
+type structTag struct {
+	skip        bool
+	key         string
+	title       string
+	description string
+	placeholder string
+	group       string
+	options     []string
+	validate    []string
+	password    bool
+	inline      bool
+	text        bool
+}
+
+func parseStructTag(tag string) (structTag, error) {
+	var st structTag
+	if tag == "-" {
+		st.skip = true
+		return st, nil
+	}
+
+	parts, err := splitStructTag(tag)
+	if err != nil {
+		return st, err
+	}
+	for _, part := range parts {
+		name, value, hasValue := strings.Cut(part, "=")
+		name = strings.TrimSpace(name)
+		switch name {
+		case "":
+			continue
+		case "password", "inline", "text":
+			if hasValue {
+				return st, fmt.Errorf("tag %q does not take a value", name)
+			}
+		default:
+			if !hasValue {
+				return st, fmt.Errorf("tag %q requires a value", name)
+			}
+		}
+
+		switch name {
+		case "key":
+			st.key = value
+		case "title":
+			st.title = value
+		case "description":
+			st.description = value
+		case "placeholder":
+			st.placeholder = value
+		case "group":
+			st.group = value
+		case "options":
+			st.options = strings.Split(value, "|")
+		case "validate":
+			st.validate = strings.Split(value, "|")
+		case "password":
+			st.password = true
+		case "inline":
+			st.inline = true
+		case "text":
+			st.text = true
+		default:
+			return st, fmt.Errorf("unknown tag %q", name)
+		}
+	}
+	return st, nil
+}
+
+// splitStructTag splits a tag on commas, keeping single quoted values intact.
+func splitStructTag(tag string) ([]string, error) {
+	var (
+		parts  []string
+		sb     strings.Builder
+		quoted bool
+	)
+	for _, r := range tag {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			parts = append(parts, sb.String())
+			sb.Reset()
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote in tag %q", tag)
+	}
+	return append(parts, sb.String()), nil
+}
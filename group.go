@@ -21,6 +21,7 @@ type Group struct {
 	selector *selector.Selector[Field]
 
 	// information
+	key         string
 	title       string
 	description string
 
@@ -41,6 +42,7 @@ type Group struct {
 	hasDarkBg bool
 	keymap    *KeyMap
 	hide      func() bool
+	next      func() string
 	active    bool
 }
 
@@ -67,6 +69,12 @@ func NewGroup(fields ...Field) *Group {
 	return group
 }
 
+// Key sets the group's key, used to refer to the group from [Group.Next].
+func (g *Group) Key(key string) *Group {
+	g.key = key
+	return g
+}
+
 // Title sets the group's title.
 func (g *Group) Title(title string) *Group {
 	g.title = title
@@ -154,10 +162,25 @@ func (g *Group) WithHideFunc(hideFunc func() bool) *Group {
 	return g
 }
 
+// Next sets the function that chooses the group shown after this one, by
+// key. This lets a form follow a graph of groups rather than their order.
+//
+// Returning an empty string moves on to the following group, as usual.
+// Returning a key that doesn't match any group submits the form, as does
+// returning the key of a group already gone through: a cycle ends the form
+// rather than asking for the same groups again.
+func (g *Group) Next(next func() string) *Group {
+	g.next = next
+	return g
+}
+
 // Errors returns the groups' fields' errors.
 func (g *Group) Errors() []error {
 	var errs []error
 	g.selector.Range(func(_ int, field Field) bool {
+		if isFieldHidden(field) {
+			return true
+		}
 		if err := field.Error(); err != nil {
 			errs = append(errs, err)
 		}
@@ -166,6 +189,12 @@ func (g *Group) Errors() []error {
 	return errs
 }
 
+// isFieldHidden returns whether the field is hidden with Hide or HideFunc.
+func isFieldHidden(field Field) bool {
+	h, ok := field.(interface{ hidden() bool })
+	return ok && h.hidden()
+}
+
 // updateFieldMsg is a message to update the fields of a group that is currently
 // displayed.
 //
@@ -208,7 +237,7 @@ func (g *Group) Init() tea.Cmd {
 	if g.selector.Selected().Skip() {
 		if g.selector.OnLast() {
 			cmds = append(cmds, g.prevField()...)
-		} else if g.selector.OnFirst() {
+		} else {
 			cmds = append(cmds, g.nextField()...)
 		}
 		return tea.Batch(cmds...)
@@ -327,14 +356,19 @@ func (g *Group) getContent() (int, string) {
 		g.selector.Selected().WithHeight(g.height)
 		fields.WriteString(g.selector.Selected().View())
 	} else {
+		first := true
 		g.selector.Range(func(i int, field Field) bool {
+			if isFieldHidden(field) {
+				return true
+			}
+			if !first {
+				fields.WriteString(gap)
+			}
+			first = false
 			fields.WriteString(field.View())
 			if i == g.selector.Index() {
 				offset = lipgloss.Height(fields.String()) - lipgloss.Height(field.View())
 			}
-			if i < g.selector.Total()-1 {
-				fields.WriteString(gap)
-			}
 			return true
 		})
 	}
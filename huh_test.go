@@ -3,12 +3,17 @@ package huh
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
@@ -320,6 +325,70 @@ func TestPasteNotDuplicated(t *testing.T) {
 	}
 }
 
+func TestInputMask(t *testing.T) {
+	var phone string
+	field := NewInput().
+		Title("Phone").
+		Mask("+1 (999) 999-9999").
+		Value(&phone)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+	requireContains(t, ansi.Strip(f.View()), "+1 (___) ___-____")
+
+	// letters are rejected and the cursor moves past literals.
+	f = typeText(f, "5a55")
+	requireEqual(t, phone, "+1 (555")
+	requireEqual(t, field.textinput.Position(), len("+1 (555) "))
+	f = typeText(f, "12")
+	requireEqual(t, phone, "+1 (555) 12")
+	f.Update(codeKeypress(tea.KeyBackspace))
+	requireEqual(t, phone, "+1 (555) 1")
+
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "must match +1 (___) ___-____")
+	f = typeText(f, "234567")
+	requireEqual(t, phone, "+1 (555) 123-4567")
+
+	// typing over a rune replaces it.
+	f.Update(codeKeypress(tea.KeyHome))
+	f = typeText(f, "8")
+	requireEqual(t, phone, "+1 (855) 123-4567")
+
+	field.MaskRaw(true)
+	requireEqual(t, phone, "8551234567")
+	requireEqual(t, field.answer("+1 (555) 010-9999"), nil)
+	requireEqual(t, phone, "5550109999")
+	requireEqual(t, field.answer("555").Error(), "must match +1 (___) ___-____")
+}
+
+func TestInputMaskPaste(t *testing.T) {
+	var code string
+	field := NewInput().Title("Code").Mask("999-999").Value(&code)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	// pasted runes that don't fit their slot are dropped, as if typed.
+	f = batchUpdate(f.Update(tea.PasteMsg{Content: "abc123456"})).(*Form)
+	requireEqual(t, code, "123-456")
+	requireContains(t, ansi.Strip(f.View()), "123-456")
+	if strings.Contains(ansi.Strip(f.View()), "abc") {
+		t.Error("expected the rejected runes not to be shown")
+	}
+}
+
+func TestInputMaskAccessible(t *testing.T) {
+	var out bytes.Buffer
+	var id string
+	field := NewInput().Title("ID").Mask("AAA-9999").Value(&id)
+	in := iotest.OneByteReader(strings.NewReader("abc\nabc1234\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, ansi.Strip(out.String()), "(___-____)")
+	requireContains(t, out.String(), "must match ___-____")
+	requireEqual(t, id, "abc-1234")
+}
+
 func TestInlineInput(t *testing.T) {
 	field := NewInput().
 		Title("Input ").
@@ -1046,6 +1115,178 @@ func TestPrevGroup(t *testing.T) {
 	}
 }
 
+func TestGroupNext(t *testing.T) {
+	var kind string
+	newForm := func() *Form {
+		return NewForm(
+			NewGroup(NewSelect[string]().Key("kind").Options(NewOptions("a", "b")...).Value(&kind)).
+				Next(func() string { return kind }),
+			NewGroup(NewInput().Key("a").Title("Group A")).Key("a").
+				Next(func() string { return "end" }),
+			NewGroup(NewInput().Key("b").Title("Group B")).Key("b"),
+			NewGroup(NewInput().Key("end").Title("Group End")).Key("end"),
+		)
+	}
+
+	kind = "b"
+	f := newForm()
+	f = batchUpdate(f, f.Init()).(*Form)
+	f.Update(nextGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "b")
+	f.Update(nextGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "end")
+	f.Update(prevGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "b")
+	f.Update(prevGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "kind")
+
+	kind = "a"
+	f.Update(nextGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "a")
+	f.Update(nextGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "end")
+	f.Update(prevGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "a")
+
+	// answers only need to cover the path taken.
+	kind = ""
+	f = newForm().WithAnswers(AnswersFromMap(map[string]any{
+		"kind": "b",
+		"b":    "bee",
+		"end":  "done",
+	}))
+	if err := f.Run(); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, f.GetString("b"), "bee")
+	requireEqual(t, f.Get("a"), nil)
+}
+
+func TestGroupNextBackwards(t *testing.T) {
+	// the last group of the slice leads to one before it.
+	newForm := func() *Form {
+		return NewForm(
+			NewGroup(NewInput().Key("a")).Key("a").Next(func() string { return "b" }),
+			NewGroup(NewInput().Key("end")).Key("end"),
+			NewGroup(NewInput().Key("b")).Key("b").Next(func() string { return "end" }),
+		)
+	}
+	f := newForm()
+	f = batchUpdate(f, f.Init()).(*Form)
+	requireEqual(t, fmt.Sprint(f.path()), "[0 2 1]")
+	f.Update(nextGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "b")
+	f.Update(nextGroup())
+	requireEqual(t, f.State, StateNormal)
+	requireEqual(t, f.GetFocusedField().GetKey(), "end")
+	f.Update(nextGroup())
+	requireEqual(t, f.State, StateCompleted)
+
+	var out bytes.Buffer
+	f = newForm().
+		WithAccessible(true).
+		WithOutput(&out).
+		WithInput(iotest.OneByteReader(strings.NewReader("1\n2\n3\n")))
+	if err := f.Run(); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, f.GetString("a"), "1")
+	requireEqual(t, f.GetString("b"), "2")
+	requireEqual(t, f.GetString("end"), "3")
+}
+
+func TestGroupNextUnknownKeySubmits(t *testing.T) {
+	f := NewForm(
+		NewGroup(NewInput().Key("first")).Next(func() string { return "nowhere" }),
+		NewGroup(NewInput().Key("second")),
+	)
+	f = batchUpdate(f, f.Init()).(*Form)
+	f.Update(nextGroup())
+	requireEqual(t, f.State, StateCompleted)
+}
+
+func TestGroupNextCycleSubmits(t *testing.T) {
+	newForm := func() *Form {
+		return NewForm(
+			NewGroup(NewInput().Key("first")).Key("first"),
+			NewGroup(NewInput().Key("second")).Next(func() string { return "first" }),
+		)
+	}
+	f := newForm()
+	f = batchUpdate(f, f.Init()).(*Form)
+	f.Update(nextGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "second")
+	f.Update(nextGroup())
+	requireEqual(t, f.State, StateCompleted)
+
+	var out bytes.Buffer
+	f = newForm().
+		WithAccessible(true).
+		WithOutput(&out).
+		WithInput(iotest.OneByteReader(strings.NewReader("1\n2\n")))
+	if err := f.Run(); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, f.State, StateCompleted)
+	requireEqual(t, f.GetString("first"), "1")
+	requireEqual(t, f.GetString("second"), "2")
+}
+
+func TestFieldHide(t *testing.T) {
+	var more bool
+	f := NewForm(NewGroup(
+		NewConfirm().Key("more").Title("More?").Value(&more),
+		NewInput().Key("details").Title("Details").
+			Validate(ValidateNotEmpty()).
+			HideFunc(func() bool { return !more }),
+		NewInput().Key("last").Title("Last"),
+	))
+	f = batchUpdate(f, f.Init()).(*Form)
+	group := f.selector.Selected()
+	height := group.height
+
+	if strings.Contains(viewModel(f), "Details") {
+		t.Error("expected details to be hidden")
+	}
+	f.Update(NextField())
+	requireEqual(t, f.GetFocusedField().GetKey(), "last")
+	f.Update(PrevField())
+	requireEqual(t, f.GetFocusedField().GetKey(), "more")
+
+	// showing the field renders it, and makes the group taller.
+	f.Update(codeKeypress(tea.KeyLeft))
+	requireEqual(t, more, true)
+	requireContains(t, viewModel(f), "Details")
+	if group.height <= height {
+		t.Errorf("expected group to grow from %d, got %d", height, group.height)
+	}
+	f.Update(NextField())
+	requireEqual(t, f.GetFocusedField().GetKey(), "details")
+	f = typeText(f, "lots")
+	f.Update(NextField())
+	requireEqual(t, f.GetString("details"), "lots")
+
+	// hiding it again leaves it out of the results.
+	f.Update(PrevField())
+	f.Update(PrevField())
+	requireEqual(t, f.GetFocusedField().GetKey(), "more")
+	f.Update(codeKeypress(tea.KeyLeft))
+	requireEqual(t, more, false)
+	f.Update(nextGroup())
+	requireEqual(t, f.State, StateCompleted)
+	requireEqual(t, f.Get("details"), nil)
+}
+
+func TestFieldHideValidation(t *testing.T) {
+	hidden := NewInput().Key("hidden").Validate(ValidateNotEmpty()).Hide(true)
+	group := NewGroup(NewInput().Key("shown"), hidden)
+	hidden.Blur()
+	if hidden.Error() == nil {
+		t.Fatal("expected the hidden input to be invalid")
+	}
+	requireEqual(t, len(group.Errors()), 0)
+}
+
 func TestNote(t *testing.T) {
 	field := NewNote().
 		Title("Taco").
@@ -1298,74 +1539,2009 @@ func TestGetFocusedField(t *testing.T) {
 	}
 }
 
-// formProgram returns a new Form with a nil input and output, so it can be used as a test program.
-func formProgram() *Form {
-	return NewForm(NewGroup(NewInput().Title("Foo"))).
-		WithInput(nil).
-		WithOutput(io.Discard).
-		WithAccessible(false)
+func TestFormFromStruct(t *testing.T) {
+	type Shell string
+	type Extras struct {
+		Notes string `huh:"title=Notes,text"`
+	}
+	type Skipped struct {
+		Hidden string `huh:"-"`
+	}
+	type Config struct {
+		Name     string   `huh:"title=Name,validate=notempty|max:8"`
+		Shell    Shell    `huh:"title=Shell,options=bash|zsh|fish"`
+		Token    string   `huh:"title='Token, please',password"`
+		Features []string `huh:"title=Features,options=a|b|c,group=Features"`
+		Agree    bool     `huh:"key=agree"`
+		Extras   Extras   `huh:"title=Extras,description=More things"`
+		Skipped  Skipped  `huh:"description=Nothing to ask"`
+		Ignored  string   `huh:"-"`
+		private  string
+	}
+
+	cfg := Config{Shell: "zsh", private: "x"}
+	f, err := FormFromStruct(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, f.selector.Total(), 3)
+	requireEqual(t, f.selector.Get(0).selector.Total(), 4)
+	requireEqual(t, f.selector.Get(1).title, "Features")
+	requireEqual(t, f.selector.Get(2).title, "Extras")
+	requireEqual(t, f.selector.Get(2).description, "More things")
+
+	fields := f.selector.Get(0)
+	if _, ok := fields.selector.Get(1).(*Select[string]); !ok {
+		t.Errorf("expected Shell to be a Select, got %T", fields.selector.Get(1))
+	}
+	requireEqual(t, fields.selector.Get(3).GetKey(), "agree")
+	if _, ok := f.selector.Get(2).selector.Get(0).(*Text); !ok {
+		t.Errorf("expected Notes to be a Text, got %T", f.selector.Get(2).selector.Get(0))
+	}
+
+	f = batchUpdate(f, f.Init()).(*Form)
+	f = typeText(f, "Taco")
+	requireEqual(t, cfg.Name, "Taco")
+	requireContains(t, viewModel(f), "> zsh")
+
+	f.NextField()
+	f.Update(keypress('j'))
+	requireEqual(t, cfg.Shell, Shell("fish"))
+
+	name := f.selector.Get(0).selector.Get(0).(*Input)
+	name.Focus()
+	name = typeText(name, "Tuesday")
+	name.Blur()
+	if name.Error() == nil {
+		t.Error("expected the max length validator to fail")
+	}
 }
 
-func batchUpdate(m Model, cmd tea.Cmd) Model {
-	if cmd == nil {
-		return m
+func TestFormFromStructErrors(t *testing.T) {
+	for name, v := range map[string]any{
+		"not a pointer":    struct{}{},
+		"unsupported type": &struct{ Age int }{},
+		"missing options":  &struct{ Tags []string }{},
+		"unknown tag": &struct {
+			Name string `huh:"colour=red"`
+		}{},
+		"unknown validate": &struct {
+			Name string `huh:"validate=email"`
+		}{},
+		"bad quote": &struct {
+			Name string `huh:"title='oops"`
+		}{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := FormFromStruct(v); err == nil {
+				t.Error("expected an error")
+			}
+		})
 	}
-	msg := cmd()
-	m, cmd = m.Update(msg)
-	if cmd == nil {
-		return m
+
+	_, err := FormFromStruct(&struct{ Age int }{})
+	requireContains(t, err.Error(), "Age")
+	requireContains(t, err.Error(), "unsupported type int")
+
+	// structs without exported fields can't be walked.
+	_, err = FormFromStruct(&struct{ Birthday time.Time }{})
+	requireEqual(t, err.Error(), "huh: field Birthday: unsupported type time.Time")
+}
+
+func TestFormAnswers(t *testing.T) {
+	var (
+		name     string
+		shell    string
+		toppings []string
+		discount bool
+		secret   string
+	)
+	newForm := func() *Form {
+		return NewForm(
+			NewGroup(
+				NewInput().Key("name").Value(&name).Validate(ValidateNotEmpty()),
+				NewSelect[string]().Key("shell").Options(NewOptions("Soft", "Hard")...).Value(&shell),
+				NewMultiSelect[string]().Key("toppings").Options(
+					NewOption("Lettuce", "lettuce"),
+					NewOption("Cheese", "cheese"),
+				).Value(&toppings),
+				NewConfirm().Key("discount").Value(&discount),
+				NewNote().Title("Thanks!"),
+			),
+			NewGroup(
+				NewInput().Key("secret").Value(&secret).Validate(ValidateNotEmpty()),
+			).WithHideFunc(func() bool { return !discount }),
+		)
+	}
+
+	f := newForm().WithAnswers(AnswersFromMap(map[string]any{
+		"name":     "Carlos",
+		"shell":    "Hard",
+		"toppings": []any{"Cheese", "lettuce"},
+		"discount": "no",
+	}))
+	if err := f.Run(); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, f.State, StateCompleted)
+	requireEqual(t, name, "Carlos")
+	requireEqual(t, f.GetString("shell"), "Hard")
+	requireEqual(t, strings.Join(toppings, ","), "cheese,lettuce")
+	requireEqual(t, discount, false)
+
+	t.Setenv("TACO_NAME", "Ana")
+	t.Setenv("TACO_SHELL", "Soft")
+	t.Setenv("TACO_TOPPINGS", "Lettuce")
+	t.Setenv("TACO_DISCOUNT", "true")
+	t.Setenv("TACO_SECRET", "hunter2")
+	f = newForm().WithAnswers(AnswersFromEnv("TACO"))
+	if err := f.Run(); err != nil {
+		t.Fatal(err)
 	}
-	msg = cmd()
-	m, _ = m.Update(msg)
-	return m
+	requireEqual(t, name, "Ana")
+	requireEqual(t, strings.Join(toppings, ","), "lettuce")
+	requireEqual(t, secret, "hunter2")
+
+	// the variables are named as EncodeDotenv names them.
+	requireEqual(t, EnvName("TACO", "first-name"), "TACO_FIRST_NAME")
+	requireEqual(t, EnvName("order-", "café"), "ORDER_CAF_")
+	requireEqual(t, EnvName("", "2nd"), "_2ND")
 }
 
-func codeKeypress(r rune) tea.KeyPressMsg {
-	return tea.KeyPressMsg(tea.Key{
-		Code: r,
-	})
+func TestFormAnswersFromFile(t *testing.T) {
+	dir := t.TempDir()
+	for file, content := range map[string]string{
+		"answers.json": `{"name": "Carlos", "agree": true}`,
+		"answers.yaml": "name: Carlos\nagree: yes\n",
+	} {
+		path := filepath.Join(dir, file)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		answers, err := AnswersFromFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f := NewForm(NewGroup(
+			NewInput().Key("name"),
+			NewConfirm().Key("agree"),
+		)).WithAnswers(answers)
+		if err := f.Run(); err != nil {
+			t.Fatal(err)
+		}
+		requireEqual(t, f.GetString("name"), "Carlos")
+		requireEqual(t, f.GetBool("agree"), true)
+	}
+
+	if _, err := AnswersFromFile(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
 }
 
-func keypress(r rune) tea.KeyPressMsg {
-	return tea.KeyPressMsg(tea.Key{
-		Text:        string(r),
-		Code:        r,
-		ShiftedCode: r,
-	})
+func TestFormAnswersError(t *testing.T) {
+	f := NewForm(NewGroup(
+		NewInput().Key("name").Validate(ValidateNotEmpty()),
+		NewInput().Key("nickname"),
+		NewInput().Key("email").Validate(ValidateNotEmpty()),
+		NewSelect[string]().Key("shell").Options(NewOptions("Soft", "Hard")...),
+		NewConfirm().Key("agree"),
+	)).WithAnswers(AnswersFromMap(map[string]any{
+		"shell": "Crunchy",
+		"agree": "maybe",
+	}))
+
+	err := f.Run()
+	var answersErr *AnswersError
+	if !errors.As(err, &answersErr) {
+		t.Fatalf("expected an AnswersError, got %v", err)
+	}
+	// a valid default isn't an answer.
+	requireEqual(t, strings.Join(answersErr.Missing, ","), "name,nickname,email")
+	requireEqual(t, len(answersErr.Invalid), 2)
+	requireEqual(t, answersErr.Invalid[0].Key, "shell")
+	requireEqual(t, answersErr.Invalid[1].Key, "agree")
+	requireContains(t, err.Error(), "missing answers for name, nickname, email")
+	requireEqual(t, f.State, StateAborted)
+
+	// character limits count runes, not bytes.
+	input := NewInput().CharLimit(4)
+	requireEqual(t, input.answer("café"), nil)
+	requireEqual(t, input.answer("cafés").Error(), "input cannot exceed 4 characters")
+	text := NewText().CharLimit(4)
+	requireEqual(t, text.answer("café"), nil)
+	requireEqual(t, text.answer("cafés").Error(), "input cannot exceed 4 characters")
 }
 
-func typeText[T Model](m T, s string) T {
-	var tm Model = m
-	for _, r := range s {
-		tm, _ = tm.Update(keypress(r))
+func TestFormReview(t *testing.T) {
+	newForm := func() *Form {
+		f := NewForm(
+			NewGroup(NewInput().Key("name").Title("Name")),
+			NewGroup(NewSelect[string]().Key("food").Title("Food").
+				Options(NewOptions("Pizza", "Salad")...)),
+			NewGroup(NewInput().Key("hidden").Title("Hidden")).WithHide(true),
+		).WithReview(true)
+		f = batchUpdate(f, f.Init()).(*Form)
+		f = typeText(f, "Frank")
+		f.Update(NextField())
+		f.Update(nextGroup())
+		f.Update(NextField())
+		f.Update(nextGroup())
+		return f
+	}
+
+	f := newForm()
+	requireEqual(t, f.State, StateNormal)
+	view := viewModel(f)
+	requireContains(t, view, "Review your answers")
+	requireContains(t, view, "> Name  Frank")
+	requireContains(t, view, "  Food  Pizza")
+	if strings.Contains(view, "Hidden") {
+		t.Error("expected hidden group to be skipped")
 	}
-	return tm.(T)
+
+	// edit the name, then come back to the review page.
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, f.GetFocusedField().GetKey(), "name")
+	f = typeText(f, "!")
+	f.Update(NextField())
+	f.Update(nextGroup())
+	requireContains(t, viewModel(f), "Name  Frank!")
+
+	f.Update(keypress('s'))
+	requireEqual(t, f.State, StateCompleted)
+	requireEqual(t, f.GetString("name"), "Frank!")
+	requireEqual(t, f.GetString("food"), "Pizza")
+
+	f = newForm()
+	f.Update(codeKeypress(tea.KeyEscape))
+	requireEqual(t, f.State, StateAborted)
+
+	// going back from an edited field leads to the group before it.
+	f = NewForm(
+		NewGroup(NewInput().Key("a")),
+		NewGroup(NewInput().Key("b")),
+		NewGroup(NewInput().Key("c")),
+	).WithReview(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+	f.Update(nextGroup())
+	f.Update(nextGroup())
+	f.Update(nextGroup())
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, f.GetFocusedField().GetKey(), "b")
+	f.Update(prevGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "a")
+
+	// a review without any answers can't be edited.
+	f = NewForm(NewGroup(NewNote().Title("Hello"), NewNote().Title("World"))).WithReview(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+	f.Update(nextGroup())
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, f.reviewCursor, 0)
+	requireContains(t, viewModel(f), "Review your answers")
+	f.Update(keypress('s'))
+	requireEqual(t, f.State, StateCompleted)
 }
 
-func TestAccessibleForm(t *testing.T) {
+func TestFormReviewBranching(t *testing.T) {
+	var kind string
+	f := NewForm(
+		NewGroup(NewSelect[string]().Key("kind").Title("Kind").
+			Options(NewOptions("a", "b")...).Value(&kind)).
+			Next(func() string { return kind }),
+		NewGroup(NewInput().Key("a").Title("Group A")).Key("a").
+			Next(func() string { return "end" }),
+		NewGroup(NewInput().Key("b").Title("Group B")).Key("b"),
+		NewGroup(NewInput().Key("end").Title("Group End")).Key("end"),
+	).WithReview(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+	f.Update(nextGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "a")
+	f.Update(nextGroup())
+	f.Update(nextGroup())
+	requireContains(t, viewModel(f), "Review your answers")
+
+	// the edit leads to a group that wasn't answered yet, which comes
+	// before going back to the review page.
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, f.GetFocusedField().GetKey(), "kind")
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(nextGroup())
+	requireEqual(t, f.GetFocusedField().GetKey(), "b")
+	f = typeText(f, "bee")
+	f.Update(nextGroup())
+	view := viewModel(f)
+	requireContains(t, view, "Review your answers")
+	requireContains(t, view, "Group B    bee")
+	if strings.Contains(view, "Group A") {
+		t.Error("expected the group no longer reached to be skipped")
+	}
+}
+
+func TestFormReviewAccessible(t *testing.T) {
 	var out bytes.Buffer
+	f := NewForm(NewGroup(
+		NewInput().Key("name").Title("Name"),
+		NewConfirm().Key("ok").Title("OK?"),
+	)).
+		WithReview(true).
+		WithAccessible(true).
+		WithOutput(&out).
+		WithInput(iotest.OneByteReader(strings.NewReader("Frank\ny\n1\nFrankie\n\nn\n")))
+
+	if err := f.Run(); !errors.Is(err, ErrUserAborted) {
+		t.Errorf("expected ErrUserAborted, got %v", err)
+	}
+	requireContains(t, out.String(), "1. Name: Frank\n")
+	requireContains(t, out.String(), "1. Name: Frankie\n")
+	requireContains(t, out.String(), "2. OK?: Yes")
+	requireEqual(t, f.GetString("name"), "Frankie")
+
+	// a review without any answers only asks whether to submit.
+	f = NewForm(NewGroup(NewNote().Title("Hello"), NewNote().Title("World"))).
+		WithReview(true).
+		WithAccessible(true).
+		WithOutput(&out).
+		WithInput(iotest.OneByteReader(strings.NewReader("y\n")))
+	if err := f.Run(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	requireEqual(t, f.State, StateCompleted)
+}
+
+func TestGetAs(t *testing.T) {
+	f := NewForm(NewGroup(
+		NewMultiSelect[string]().Key("toppings").Options(NewOptions("Cheese", "Ham")...),
+	)).WithAnswers(AnswersFromMap(map[string]any{"toppings": "Cheese,Ham"}))
+	if err := f.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	toppings, err := GetAs[[]string](f, "toppings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, strings.Join(toppings, ","), "Cheese,Ham")
+
+	if _, err := GetAs[string](f, "toppings"); err == nil {
+		t.Error("expected an error for the wrong type")
+	} else {
+		requireContains(t, err.Error(), `result for "toppings" is []string, not string`)
+	}
+	if _, err := GetAs[string](f, "missing"); err == nil {
+		t.Error("expected an error for a missing key")
+	}
+}
 
+func TestFormResults(t *testing.T) {
+	name := "Frank"
 	f := NewForm(
 		NewGroup(
-			NewInput().Title("Hello:"),
+			NewInput().Key("name").Value(&name),
+			NewInput().Key("secret").Hide(true),
+			NewNote().Title("Note"),
 		),
-	).
+		NewGroup(NewInput().Key("skipped")).WithHide(true),
+		NewGroup(NewConfirm().Key("ok")),
+	)
+
+	results := f.Results()
+	requireEqual(t, len(results), 2)
+	requireEqual(t, results["name"], any("Frank"))
+	requireEqual(t, results["ok"], any(false))
+}
+
+func TestEncodeResults(t *testing.T) {
+	results := map[string]any{
+		"name":     "Frank \"The Tank\"",
+		"toppings": []string{"Cheese", "Ham"},
+		"vip":      true,
+		"age-2":    42,
+	}
+
+	var sb strings.Builder
+	if err := EncodeDotenv(&sb, results); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, sb.String(), `AGE_2="42"
+NAME="Frank \"The Tank\""
+TOPPINGS="Cheese,Ham"
+VIP="true"
+`)
+
+	// values are escaped as dotenv files expect, not as Go strings.
+	sb.Reset()
+	if err := EncodeDotenv(&sb, map[string]any{"café": "Crème\n$5 \\o/"}); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, sb.String(), "CAF_=\"Crème\\n\\$5 \\\\o/\"\n")
+
+	sb.Reset()
+	if err := EncodeJSON(&sb, results); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, sb.String(), `{
+  "age-2": 42,
+  "name": "Frank \"The Tank\"",
+  "toppings": [
+    "Cheese",
+    "Ham"
+  ],
+  "vip": true
+}
+`)
+
+	sb.Reset()
+	if err := EncodeYAML(&sb, results); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, sb.String(), `age-2: 42
+name: Frank "The Tank"
+toppings:
+  - Cheese
+  - Ham
+vip: true
+`)
+}
+
+func TestDecodeResults(t *testing.T) {
+	type Extra struct {
+		Age float64 `huh:"key=age"`
+	}
+	var v struct {
+		Name     string `huh:"key=name"`
+		Toppings []string
+		Tags     []string
+		VIP      bool   `huh:"key=vip"`
+		Ignored  string `huh:"-"`
+		Extra    Extra
+		Birthday time.Time
+	}
+	birthday := time.Date(1990, time.May, 4, 0, 0, 0, 0, time.UTC)
+	err := DecodeResults(map[string]any{
+		"Birthday": birthday,
+		"name":     "Frank",
+		"Toppings": []string{"Cheese"},
+		"Tags":     []any{"a", "b"},
+		"vip":      true,
+		"age":      42,
+		"Ignored":  "nope",
+		"unknown":  1,
+	}, &v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, v.Name, "Frank")
+	requireEqual(t, strings.Join(v.Toppings, ","), "Cheese")
+	requireEqual(t, strings.Join(v.Tags, ","), "a,b")
+	requireEqual(t, v.VIP, true)
+	requireEqual(t, v.Extra.Age, 42.0)
+	requireEqual(t, v.Ignored, "")
+	requireEqual(t, v.Birthday, birthday)
+
+	err = DecodeResults(map[string]any{"vip": "yes"}, &v)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	requireContains(t, err.Error(), "field VIP: can't decode string into bool")
+
+	if err := DecodeResults(nil, v); err == nil {
+		t.Error("expected an error for a non-pointer")
+	}
+
+	// numbers are only decoded into integers as is.
+	var n struct{ Count int }
+	if err := DecodeResults(map[string]any{"Count": 3.0}, &n); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, n.Count, 3)
+	err = DecodeResults(map[string]any{"Count": 3.5}, &n)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	requireContains(t, err.Error(), "field Count: can't decode 3.5 into int")
+
+	// nor lose their sign, or overflow.
+	var u struct {
+		Count uint
+		Small int8
+		Ratio float32
+	}
+	for _, tc := range []struct {
+		key   string
+		value any
+		want  string
+	}{
+		{"Count", -1, "can't decode -1 into uint"},
+		{"Count", -1.0, "can't decode -1 into uint"},
+		{"Small", 300, "can't decode 300 into int8"},
+		{"Small", uint64(200), "can't decode 200 into int8"},
+		{"Small", 1e300, "can't decode 1e+300 into int8"},
+		{"Ratio", 1e300, "can't decode 1e+300 into float32"},
+	} {
+		err := DecodeResults(map[string]any{tc.key: tc.value}, &u)
+		if err == nil {
+			t.Fatalf("expected an error for %s: %v", tc.key, tc.value)
+		}
+		requireEqual(t, err.Error(), "huh: field "+tc.key+": "+tc.want)
+	}
+	if err := DecodeResults(map[string]any{"Count": 7, "Small": -128.0, "Ratio": 0.5}, &u); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, u.Count, uint(7))
+	requireEqual(t, u.Small, int8(-128))
+}
+
+// memoryDraft is a DraftStore kept in memory.
+type memoryDraft struct{ draft *Draft }
+
+func (m *memoryDraft) Load() (*Draft, error)   { return m.draft, nil }
+func (m *memoryDraft) Save(draft *Draft) error { m.draft = draft; return nil }
+func (m *memoryDraft) Delete() error           { m.draft = nil; return nil }
+
+func TestDraftFile(t *testing.T) {
+	store := NewDraftFile(filepath.Join(t.TempDir(), "drafts", "form.json"))
+	draft, err := store.Load()
+	if err != nil || draft != nil {
+		t.Fatalf("expected no draft, got %v, %v", draft, err)
+	}
+
+	if err := store.Save(&Draft{Group: 1, Field: 2, Values: map[string]any{"name": "Frank"}}); err != nil {
+		t.Fatal(err)
+	}
+	draft, err = store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, draft.Group, 1)
+	requireEqual(t, draft.Field, 2)
+	requireEqual(t, draft.Values["name"], any("Frank"))
+
+	if err := store.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete(); err != nil {
+		t.Fatalf("expected deleting a missing draft to succeed, got %v", err)
+	}
+	if draft, _ := store.Load(); draft != nil {
+		t.Error("expected the draft to be deleted")
+	}
+}
+
+func TestFormDraft(t *testing.T) {
+	newForm := func(store DraftStore) *Form {
+		return NewForm(
+			NewGroup(
+				NewInput().Key("name").Title("Name"),
+				NewConfirm().Key("vip").Title("VIP?"),
+			),
+			NewGroup(
+				NewSelect[string]().Key("food").Title("Food").Options(NewOptions("Pizza", "Salad")...),
+			),
+		).WithDraft(store)
+	}
+
+	store := &memoryDraft{}
+	f := newForm(store)
+	f = batchUpdate(f, f.Init()).(*Form)
+	f = typeText(f, "Frank")
+	f.Update(NextField())
+	if store.draft == nil {
+		t.Fatal("expected a draft to be saved")
+	}
+	requireEqual(t, store.draft.Group, 0)
+	requireEqual(t, store.draft.Field, 1)
+	requireEqual(t, store.draft.Values["name"], any("Frank"))
+
+	f.GetFocusedField().(*Confirm).accessor.Set(true)
+	f.Update(NextField())
+	f.Update(nextGroup())
+	requireEqual(t, store.draft.Group, 1)
+	requireEqual(t, store.draft.Values["vip"], any(true))
+
+	// resuming pre-fills the fields and starts from the draft's group.
+	var out bytes.Buffer
+	f = newForm(store).
 		WithAccessible(true).
 		WithOutput(&out).
-		WithInput(strings.NewReader("carlos\n"))
+		WithInput(iotest.OneByteReader(strings.NewReader("y\n2\n")))
+	if err := f.Run(); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, out.String(), "Resume where you left off?")
+	if strings.Contains(out.String(), "Name") {
+		t.Error("expected the first group to be skipped")
+	}
+	requireEqual(t, f.GetString("name"), "Frank")
+	requireEqual(t, f.GetBool("vip"), true)
+	requireEqual(t, f.GetString("food"), "Salad")
+	if store.draft != nil {
+		t.Error("expected the draft to be deleted on submit")
+	}
+}
 
+func TestFormDraftStartOver(t *testing.T) {
+	store := &memoryDraft{draft: &Draft{Group: 0, Values: map[string]any{"name": "Frank"}}}
+	f := NewForm(NewGroup(NewInput().Key("name").Title("Name"))).
+		WithDraft(store).
+		WithAccessible(true).
+		WithOutput(io.Discard).
+		WithInput(iotest.OneByteReader(strings.NewReader("n\nAlex\n")))
 	if err := f.Run(); err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	requireEqual(t, f.GetString("name"), "Alex")
+	if store.draft != nil {
+		t.Error("expected the draft to be deleted")
 	}
+}
 
-	if !strings.Contains(out.String(), "Hello:") {
-		t.Error("invalid output:\n", out.String())
+func TestFormDraftSkipsPasswords(t *testing.T) {
+	store := &memoryDraft{}
+	f := NewForm(NewGroup(
+		NewInput().Key("name").Title("Name"),
+		NewInput().Key("pin").Title("PIN").EchoMode(EchoModePassword),
+		NewPassword().Key("password").Title("Password").Confirm(false),
+		NewConfirm().Key("ok").Title("OK?"),
+	)).WithDraft(store)
+	f = batchUpdate(f, f.Init()).(*Form)
+	f = typeText(f, "Frank")
+	f.Update(NextField())
+	f = typeText(f, "1234")
+	f.Update(NextField())
+	f = typeText(f, "hunter2")
+	f.Update(NextField())
+	if store.draft == nil {
+		t.Fatal("expected a draft to be saved")
+	}
+	requireEqual(t, store.draft.Values["name"], any("Frank"))
+	for _, key := range []string{"pin", "password"} {
+		if _, ok := store.draft.Values[key]; ok {
+			t.Errorf("expected %q not to be saved", key)
+		}
 	}
 }
 
-func TestAccessibleFields(t *testing.T) {
-	for name, test := range map[string]struct {
-		Field       Field
+func TestNumber(t *testing.T) {
+	var age int
+	field := NewNumber[int]().Title("Age").Min(0).Max(3).Value(&age)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	// letters and misplaced signs are rejected.
+	f = typeText(f, "1a-2")
+	requireEqual(t, age, 12)
+	requireContains(t, viewModel(f), "12")
+
+	// submitting checks the bounds.
+	f.Update(codeKeypress(tea.KeyEnter))
+	if field.Error() == nil || field.Error().Error() != "must be at most 3" {
+		t.Errorf("expected a bounds error, got %v", field.Error())
+	}
+
+	// stepping is clamped to the bounds.
+	f.Update(codeKeypress(tea.KeyUp))
+	requireEqual(t, age, 3)
+	for range 5 {
+		f.Update(codeKeypress(tea.KeyDown))
+	}
+	requireEqual(t, age, 0)
+	f = typeText(f, "-")
+	requireEqual(t, field.textinput.Value(), "0")
+}
+
+func TestNumberFloat(t *testing.T) {
+	var price float64
+	field := NewNumber[float64]().Step(0.25).Precision(2).Value(&price)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f = typeText(f, "1.5.0")
+	requireEqual(t, price, 1.5)
+	f.Update(codeKeypress(tea.KeyUp))
+	requireEqual(t, price, 1.75)
+	requireEqual(t, field.textinput.Value(), "1.75")
+
+	field.Blur()
+	field.textinput.SetValue("2.499")
+	field.Blur()
+	requireEqual(t, price, 2.5)
+	requireEqual(t, field.textinput.Value(), "2.50")
+}
+
+func TestNumberAccessible(t *testing.T) {
+	var out bytes.Buffer
+	price := 1.5
+	field := NewNumber[float64]().Title("Price").Max(10).Value(&price)
+	in := iotest.OneByteReader(strings.NewReader("abc\n12\n2.25\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, price, 2.25)
+	requireContains(t, out.String(), "must be a number")
+	requireContains(t, out.String(), "must be at most 10")
+
+	// an empty line keeps the default only if it's valid.
+	out.Reset()
+	var count int
+	counter := NewNumber[int]().Title("Count").Min(1).Value(&count)
+	in = iotest.OneByteReader(strings.NewReader("\n3\n"))
+	if err := counter.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, count, 3)
+	requireContains(t, out.String(), "must be at least 1")
+}
+
+func TestDatePicker(t *testing.T) {
+	date := time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)
+	field := NewDatePicker().
+		Title("When?").
+		Value(&date).
+		Min(time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC)).
+		Max(time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)).
+		FirstWeekday(time.Monday)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := ansi.Strip(f.View())
+	requireContains(t, view, "January 2026")
+	requireContains(t, view, "Mo Tu We Th Fr Sa Su")
+	requireContains(t, view, "26 27 28 29 30 31")
+
+	// months keep the day within the month, and the bounds.
+	f.Update(codeKeypress(tea.KeyPgDown))
+	requireEqual(t, date, time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC))
+	f.Update(codeKeypress(tea.KeyPgDown))
+	requireEqual(t, date, time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC))
+	f.Update(codeKeypress(tea.KeyRight))
+	requireEqual(t, date.Day(), 15)
+	f.Update(codeKeypress(tea.KeyLeft))
+	f.Update(codeKeypress(tea.KeyUp))
+	requireEqual(t, date, time.Date(2026, time.March, 7, 0, 0, 0, 0, time.UTC))
+	for range 3 {
+		f.Update(codeKeypress(tea.KeyPgUp))
+	}
+	requireEqual(t, date, time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC))
+}
+
+func TestDatePickerTime(t *testing.T) {
+	date := time.Date(2026, time.October, 17, 9, 30, 0, 0, time.UTC)
+	field := NewDatePicker().Value(&date).Time(true)
+	f := NewForm(NewGroup(field, NewNote()))
+	f.Update(f.Init())
+
+	// enter moves on to the time of day.
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireContains(t, ansi.Strip(f.View()), "Time 09:30")
+	f.Update(codeKeypress(tea.KeyUp))
+	f.Update(codeKeypress(tea.KeyRight))
+	f.Update(codeKeypress(tea.KeyDown))
+	requireEqual(t, date, time.Date(2026, time.October, 24, 10, 29, 0, 0, time.UTC))
+
+	batchUpdate(f.Update(codeKeypress(tea.KeyEnter)))
+	requireEqual(t, field.focused, false)
+	_, value := field.review()
+	requireEqual(t, value, "2026-10-24 10:29")
+}
+
+func TestDatePickerAccessible(t *testing.T) {
+	var out bytes.Buffer
+	var date time.Time
+	field := NewDatePicker().
+		Title("Birthday").
+		Value(&date).
+		Layout("02/01/2006").
+		Max(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.Local))
+	in := iotest.OneByteReader(strings.NewReader("tomorrow\n04/05/2001\n04/05/1990\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, date, time.Date(1990, time.May, 4, 0, 0, 0, 0, time.Local))
+	requireContains(t, out.String(), "Birthday (02/01/2006):")
+	requireContains(t, out.String(), "must be a date like")
+	requireContains(t, out.String(), "must be on or before 01/01/2000")
+}
+
+func TestDateRangePicker(t *testing.T) {
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	var r DateRange
+	field := NewDateRangePicker().Value(&r).MaxDays(7)
+	field.now = func() time.Time { return day(time.October, 17) }
+	field.load()
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f.Update(keypress(' '))
+	for range 3 {
+		f.Update(codeKeypress(tea.KeyRight))
+	}
+	requireContains(t, ansi.Strip(f.View()), "2026-10-17 → ?")
+	f.Update(keypress(' '))
+	requireEqual(t, r, DateRange{Start: day(time.October, 17), End: day(time.October, 20)})
+
+	// picking an end before the start starts over.
+	f.Update(keypress(' '))
+	f.Update(codeKeypress(tea.KeyUp))
+	f.Update(keypress(' '))
+	requireEqual(t, r, DateRange{Start: day(time.October, 13), End: day(time.October, 13)})
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(keypress(' '))
+	requireEqual(t, field.Error().Error(), "must span at most 7 days")
+
+	f.Update(keypress('1'))
+	requireEqual(t, r, DateRange{Start: day(time.October, 11), End: day(time.October, 17)})
+	requireEqual(t, field.Error(), nil)
+	f.Update(keypress('3'))
+	requireEqual(t, r, DateRange{Start: day(time.October, 1), End: day(time.October, 31)})
+	requireEqual(t, field.Error().Error(), "must span at most 7 days")
+
+	// leaving with only the start picked fails validation.
+	f.Update(keypress('1'))
+	f.Update(keypress(' '))
+	field.Blur()
+	requireEqual(t, field.Error().Error(), "pick the end of the range")
+	requireEqual(t, len(f.selector.Selected().Errors()), 1)
+	field.Focus()
+	f.Update(keypress(' '))
+	requireEqual(t, field.Error(), nil)
+	field.Blur()
+	requireEqual(t, field.Error(), nil)
+}
+
+func TestDateRangePickerAnswers(t *testing.T) {
+	field := NewDateRangePicker().Key("period")
+	if err := field.answer("2026-01-01..2026-01-03"); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, field.GetValue().(DateRange).String(), "2026-01-01..2026-01-03")
+	if err := field.answer("2026-01-03..2026-01-01"); err == nil {
+		t.Error("expected an error for a range that ends before it starts")
+	}
+
+	// drafts store ranges as JSON.
+	var draft map[string]any
+	data, _ := json.Marshal(DateRange{Start: time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local), End: time.Date(2026, 2, 5, 0, 0, 0, 0, time.Local)})
+	_ = json.Unmarshal(data, &draft)
+	if err := field.answer(draft); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, field.GetValue().(DateRange).String(), "2026-02-01..2026-02-05")
+}
+
+func TestDateRangePickerAccessible(t *testing.T) {
+	var out bytes.Buffer
+	var r DateRange
+	field := NewDateRangePicker().Title("Period").Value(&r)
+	in := iotest.OneByteReader(strings.NewReader("2026-01-10\n2026-01-05\n2026-01-10\n2026-01-12\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, r.String(), "2026-01-10..2026-01-12")
+	requireContains(t, out.String(), "must end after it starts")
+}
+
+func TestSlider(t *testing.T) {
+	replicas := 3
+	field := NewSlider[int]().
+		Title("Replicas").
+		Range(0, 10).
+		BigStep(5).
+		Ticks(map[int]string{0: "none", 5: "half", 10: "all"}).
+		Value(&replicas)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f.Update(codeKeypress(tea.KeyRight))
+	requireEqual(t, replicas, 4)
+	f.Update(tea.KeyPressMsg{Code: tea.KeyRight, Mod: tea.ModShift})
+	requireEqual(t, replicas, 9)
+	f.Update(keypress('L'))
+	requireEqual(t, replicas, 10)
+	f.Update(keypress('h'))
+	requireEqual(t, replicas, 9)
+
+	view := ansi.Strip(f.View())
+	requireContains(t, view, "━●─")
+	requireContains(t, view, "─ 9")
+	requireContains(t, view, "none")
+	requireContains(t, view, "half")
+	requireContains(t, view, "all")
+}
+
+func TestSliderFloat(t *testing.T) {
+	var ratio float64
+	field := NewSlider[float64]().Range(0, 1).Step(0.1).Value(&ratio)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	for range 3 {
+		f.Update(codeKeypress(tea.KeyRight))
+	}
+	requireEqual(t, ratio, 0.3)
+	_, value := field.review()
+	requireEqual(t, value, "0.3")
+
+	// the value is clamped into the range, whichever is set first.
+	ratio = 2
+	field = NewSlider[float64]().Value(&ratio).Range(0, 1)
+	requireEqual(t, field.GetValue(), any(1.0))
+	field.Focus()
+	requireEqual(t, ratio, 1.0)
+	port := 300
+	NewSlider[int]().Value(&port).Range(200, 500).Focus()
+	requireEqual(t, port, 300)
+	port = 600
+	NewSlider[int]().Range(200, 500).Value(&port).Focus()
+	requireEqual(t, port, 500)
+	level := NewSlider[int]().Range(10, 20)
+	requireEqual(t, level.GetValue(), any(10))
+	f = NewForm(NewGroup(level))
+	f.Update(f.Init())
+	_, cmd := f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, level.Error(), nil)
+	requireEqual(t, cmd != nil, true)
+}
+
+func TestSliderAccessible(t *testing.T) {
+	var out bytes.Buffer
+	var ratio float64
+	field := NewSlider[float64]().Title("Ratio").Range(0, 1).Value(&ratio)
+	in := iotest.OneByteReader(strings.NewReader("2\n0.5\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, ratio, 0.5)
+	requireContains(t, out.String(), "Ratio (0-1):")
+	requireContains(t, out.String(), "must be between 0 and 1")
+
+	// the default is clamped into the range.
+	var port int
+	slider := NewSlider[int]().Title("Port").Range(8000, 8080).Value(&port)
+	if err := slider.RunAccessible(&out, strings.NewReader("\n")); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, port, 8000)
+}
+
+func TestList(t *testing.T) {
+	var hosts []string
+	field := NewList().
+		Title("Hosts").
+		Value(&hosts).
+		Min(1).
+		Max(3).
+		ValidateItem(func(s string) error {
+			if strings.Contains(s, " ") {
+				return errors.New("no spaces")
+			}
+			return nil
+		})
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	// moving on needs at least one item.
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "at least 1 items")
+
+	for _, host := range []string{"a.example", "b c", "b.example", "c.example"} {
+		f = typeText(f, host)
+		f.Update(codeKeypress(tea.KeyEnter))
+		if host == "b c" {
+			requireEqual(t, field.Error().Error(), "no spaces")
+			field.textinput.SetValue("")
+		}
+	}
+	requireEqual(t, strings.Join(hosts, ","), "a.example,b.example,c.example")
+	f = typeText(f, "d.example")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "at most 3 items")
+	field.textinput.SetValue("")
+
+	// select b.example, move it up, edit it and delete c.example.
+	f.Update(codeKeypress(tea.KeyUp))
+	f.Update(codeKeypress(tea.KeyUp))
+	requireContains(t, ansi.Strip(f.View()), "> b.example")
+	f.Update(keypress('K'))
+	requireEqual(t, strings.Join(hosts, ","), "b.example,a.example,c.example")
+	f.Update(keypress('e'))
+	f.Update(codeKeypress(tea.KeyBackspace))
+	f = typeText(f, "e.org")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, strings.Join(hosts, ","), "b.example.org,a.example,c.example")
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(keypress('d'))
+	requireEqual(t, strings.Join(hosts, ","), "b.example.org,a.example")
+
+	batchUpdate(f.Update(codeKeypress(tea.KeyTab)))
+	requireEqual(t, field.Error(), nil)
+}
+
+func TestListAccessible(t *testing.T) {
+	var out bytes.Buffer
+	hosts := []string{"a.example"}
+	field := NewList().Title("Hosts").Value(&hosts).Min(2)
+	in := iotest.OneByteReader(strings.NewReader("\nb.example\n\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, strings.Join(hosts, ","), "a.example,b.example")
+	requireContains(t, out.String(), "- a.example")
+	requireContains(t, out.String(), "at least 2 items")
+}
+
+func TestKeyValue(t *testing.T) {
+	env := map[string]string{"TOKEN": "secret"}
+	field := NewKeyValue().
+		Title("Environment").
+		Value(&env).
+		EchoMode(EchoModePassword).
+		ValidateKey(func(s string) error {
+			if strings.ToUpper(s) != s {
+				return errors.New("keys must be upper case")
+			}
+			return nil
+		})
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := ansi.Strip(f.View())
+	requireContains(t, view, "Key")
+	requireContains(t, view, "******")
+	if strings.Contains(view, "secret") {
+		t.Fatalf("value should be masked:\n%s", view)
+	}
+
+	// add a row below TOKEN, with a key that fails validation first.
+	f.Update(tea.KeyPressMsg{Code: 'n', Mod: tea.ModCtrl})
+	f = typeText(f, "home")
+	f.Update(codeKeypress(tea.KeyTab))
+	requireEqual(t, field.Error().Error(), "keys must be upper case")
+	field.textinput.SetValue("")
+	f = typeText(f, "HOME")
+	f.Update(codeKeypress(tea.KeyTab))
+	f = typeText(f, "/root")
+	requireEqual(t, env["HOME"], "/root")
+
+	// a duplicate key is flagged.
+	f.Update(tea.KeyPressMsg{Code: 'n', Mod: tea.ModCtrl})
+	f = typeText(f, "TOKEN")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), `duplicate key "TOKEN"`)
+	f.Update(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+	requireEqual(t, len(env), 2)
+
+	// going back a cell edits the token.
+	requireEqual(t, field.row, 1)
+	f.Update(tea.KeyPressMsg{Code: tea.KeyTab, Mod: tea.ModShift})
+	requireEqual(t, field.row, 0)
+	requireEqual(t, field.column, valueColumn)
+	f = typeText(f, "!")
+	requireEqual(t, env["TOKEN"], "secret!")
+
+	batchUpdate(f.Update(codeKeypress(tea.KeyEnter)))
+	requireEqual(t, field.Error(), nil)
+	_, review := field.review()
+	requireEqual(t, review, "TOKEN=*******, HOME=*****")
+
+	requireEqual(t, field.answer([]any{"A=1", "B=x=y"}), nil)
+	requireEqual(t, env["B"], "x=y")
+	requireEqual(t, field.answer("C").Error(), `"C" must be KEY=VALUE`)
+}
+
+func TestKeyValueAccessible(t *testing.T) {
+	var out bytes.Buffer
+	labels := map[string]string{"app": "web"}
+	field := NewKeyValue().Title("Labels").Value(&labels)
+	in := iotest.OneByteReader(strings.NewReader("app\ntier\nfrontend\n\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, len(labels), 2)
+	requireEqual(t, labels["tier"], "frontend")
+	requireContains(t, out.String(), "app=web")
+	requireContains(t, out.String(), `duplicate key "app"`)
+}
+
+func tableRows() []TableRow[string] {
+	return []TableRow[string]{
+		NewTableRow("web-1", "web-1", "Running", "3"),
+		NewTableRow("db-0", "db-0", "Pending", "12"),
+		NewTableRow("api-2", "api-2", "Running", "0"),
+	}
+}
+
+func TestTableSelect(t *testing.T) {
+	var pod string
+	field := NewTableSelect[string]().
+		Title("Pod").
+		Columns("Name", "Status", "Restarts").
+		Rows(tableRows()...).
+		Value(&pod)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := ansi.Strip(f.View())
+	requireContains(t, view, "Name    Status    Restarts")
+	requireContains(t, view, "> web-1   Running   3")
+	requireEqual(t, pod, "web-1")
+
+	// sorting by the third column compares numbers.
+	for range 3 {
+		f.Update(keypress('s'))
+	}
+	requireContains(t, ansi.Strip(f.View()), "Restarts ▲")
+	requireContains(t, ansi.Strip(f.View()), "> web-1")
+	f.Update(codeKeypress(tea.KeyDown))
+	requireEqual(t, pod, "db-0")
+	f.Update(keypress('S'))
+	requireContains(t, ansi.Strip(f.View()), "Restarts ▼")
+	f.Update(keypress('G'))
+	requireEqual(t, pod, "api-2")
+
+	// filtering matches any cell.
+	f.Update(keypress('/'))
+	f = typeText(f, "pend")
+	requireEqual(t, pod, "db-0")
+	view = ansi.Strip(f.View())
+	if strings.Contains(view, "web-1") {
+		t.Fatalf("web-1 should be filtered out:\n%s", view)
+	}
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.GetFiltering(), false)
+	f.Update(codeKeypress(tea.KeyEscape))
+	requireContains(t, ansi.Strip(f.View()), "web-1")
+
+	// while filtering, the up and down bindings move the cursor unless they
+	// type a letter.
+	f.Update(keypress('/'))
+	hovered := pod
+	f.Update(tea.KeyPressMsg{Code: 'n', Mod: tea.ModCtrl})
+	if pod == hovered {
+		t.Fatalf("ctrl+n should move the cursor from %s", pod)
+	}
+	f.Update(tea.KeyPressMsg{Code: 'p', Mod: tea.ModCtrl})
+	requireEqual(t, pod, hovered)
+	f.Update(keypress('j'))
+	requireEqual(t, field.filter.Value(), "j")
+	f.Update(codeKeypress(tea.KeyEscape))
+	f.Update(codeKeypress(tea.KeyEscape))
+
+	requireEqual(t, field.answer("api-2"), nil)
+	requireEqual(t, pod, "api-2")
+	_, review := field.review()
+	requireEqual(t, review, "api-2")
+}
+
+func TestTableSelectMultiple(t *testing.T) {
+	var pods []string
+	field := NewTableSelect[string]().
+		Columns("Name", "Status", "Restarts").
+		Rows(tableRows()...).
+		Values(&pods).
+		Limit(2)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f.Update(keypress('x'))
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(keypress('x'))
+	f.Update(codeKeypress(tea.KeyUp))
+	f.Update(keypress('x'))
+	requireEqual(t, strings.Join(pods, ","), "web-1,api-2")
+	requireContains(t, ansi.Strip(f.View()), "✓ api-2")
+
+	requireEqual(t, field.answer("db-0, web-1"), nil)
+	requireEqual(t, strings.Join(pods, ","), "web-1,db-0")
+	requireEqual(t, field.answer([]string{"db-0", "web-1", "api-2"}).Error(), "can't select more than 2 rows")
+}
+
+func TestTableSelectAccessible(t *testing.T) {
+	var out bytes.Buffer
+	var pods []string
+	field := NewTableSelect[string]().
+		Title("Pods").
+		Columns("Name", "Status", "Restarts").
+		Rows(tableRows()...).
+		Values(&pods)
+	in := iotest.OneByteReader(strings.NewReader("2\n3\n0\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, strings.Join(pods, ","), "db-0,api-2")
+	requireContains(t, ansi.Strip(out.String()), "1.   web-1   Running   3")
+	requireContains(t, ansi.Strip(out.String()), "2. ✓ db-0")
+}
+
+// repoTree returns the children of the nodes of an org → team → repo tree,
+// counting the loads.
+func repoTree(loads *int) func(string) []Option[string] {
+	tree := map[string][]string{
+		"charm":       {"charm/tui", "charm/infra"},
+		"charm/tui":   {"charm/tui/huh", "charm/tui/bubbles"},
+		"charm/infra": {"charm/infra/soft-serve"},
+		"acme":        {"acme/web"},
+		"acme/web":    {"acme/web/site"},
+	}
+	return func(parent string) []Option[string] {
+		*loads++
+		var options []Option[string]
+		for _, child := range tree[parent] {
+			options = append(options, NewOption(child[strings.LastIndex(child, "/")+1:], child))
+		}
+		return options
+	}
+}
+
+func TestTreeSelect(t *testing.T) {
+	var repo string
+	var loads int
+	field := NewTreeSelect[string]().
+		Title("Repository").
+		Options(NewOptions("charm", "acme")...).
+		Children(repoTree(&loads)).
+		Value(&repo)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	requireContains(t, ansi.Strip(f.View()), "> ▸ charm")
+	requireEqual(t, loads, 0)
+
+	// expand charm, move into it and expand tui.
+	f.Update(codeKeypress(tea.KeyRight))
+	requireEqual(t, loads, 1)
+	f.Update(codeKeypress(tea.KeyRight))
+	requireEqual(t, repo, "charm/tui")
+	f.Update(codeKeypress(tea.KeyRight))
+	f.Update(codeKeypress(tea.KeyDown))
+	requireEqual(t, repo, "charm/tui/huh")
+	view := ansi.Strip(f.View())
+	requireContains(t, view, "▾ charm")
+	requireContains(t, view, "  ▾ tui")
+	requireContains(t, view, ">     ▸ huh")
+
+	// collapsing from a leaf goes to its parent, then collapses it.
+	f.Update(codeKeypress(tea.KeyLeft))
+	requireEqual(t, repo, "charm/tui")
+	f.Update(codeKeypress(tea.KeyLeft))
+	if strings.Contains(ansi.Strip(f.View()), "huh") {
+		t.Fatal("tui should be collapsed")
+	}
+
+	// filtering keeps the ancestors of the loaded matches.
+	f.Update(keypress('/'))
+	f = typeText(f, "bub")
+	view = ansi.Strip(f.View())
+	requireContains(t, view, "charm")
+	requireContains(t, view, "tui")
+	requireContains(t, view, "bubbles")
+	if strings.Contains(view, "acme") || strings.Contains(view, "infra") {
+		t.Fatalf("only the ancestors of bubbles should be shown:\n%s", view)
+	}
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyDown))
+	requireEqual(t, repo, "charm/tui/bubbles")
+	f.Update(tea.KeyPressMsg{Code: 'p', Mod: tea.ModCtrl})
+	requireEqual(t, repo, "charm/tui")
+	f.Update(tea.KeyPressMsg{Code: 'n', Mod: tea.ModCtrl})
+	requireEqual(t, repo, "charm/tui/bubbles")
+	f.Update(codeKeypress(tea.KeyEnter))
+	f.Update(codeKeypress(tea.KeyEscape))
+	requireContains(t, ansi.Strip(f.View()), ">     ▸ bubbles")
+	requireEqual(t, repo, "charm/tui/bubbles")
+
+	requireEqual(t, field.answer("site"), nil)
+	requireEqual(t, repo, "acme/web/site")
+}
+
+func TestTreeSelectCascade(t *testing.T) {
+	var repos []string
+	var loads int
+	field := NewTreeSelect[string]().
+		Options(NewOptions("charm", "acme")...).
+		Children(repoTree(&loads)).
+		Values(&repos).
+		Cascade(true)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	// checking charm checks the children loaded later on.
+	f.Update(keypress('x'))
+	requireEqual(t, strings.Join(repos, ","), "charm")
+	f.Update(codeKeypress(tea.KeyRight))
+	f.Update(codeKeypress(tea.KeyRight))
+	f.Update(keypress('x'))
+	f.Update(keypress('x'))
+	requireEqual(t, strings.Join(repos, ","), "charm,charm/tui,charm/infra")
+
+	// unchecking a child unchecks its ancestors.
+	f.Update(codeKeypress(tea.KeyRight))
+	f.Update(codeKeypress(tea.KeyRight))
+	f.Update(keypress('x'))
+	requireEqual(t, strings.Join(repos, ","), "charm/tui/bubbles,charm/infra")
+	f.Update(keypress('x'))
+	requireEqual(t, strings.Join(repos, ","), "charm,charm/tui,charm/tui/huh,charm/tui/bubbles,charm/infra")
+}
+
+func TestTreeSelectAccessible(t *testing.T) {
+	var out bytes.Buffer
+	var repos []string
+	var loads int
+	field := NewTreeSelect[string]().
+		Title("Repositories").
+		Options(NewOptions("charm", "acme")...).
+		Children(repoTree(&loads)).
+		Values(&repos).
+		Cascade(true)
+	in := iotest.OneByteReader(strings.NewReader("6\n0\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, out.String(), "3.   charm / tui / huh")
+	requireEqual(t, strings.Join(repos, ","), "charm/infra,charm/infra/soft-serve")
+}
+
+func TestRank(t *testing.T) {
+	var order []string
+	field := NewRank[string]().
+		Title("Migration order").
+		Options(NewOptions("users", "orders", "invoices", "audit")...).
+		Value(&order)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+	requireEqual(t, strings.Join(order, ","), "users,orders,invoices,audit")
+
+	// grab invoices and move it to the top.
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeySpace))
+	requireContains(t, ansi.Strip(f.View()), "> 3. invoices ↕")
+	f.Update(codeKeypress(tea.KeyUp))
+	f.Update(codeKeypress(tea.KeyUp))
+	f.Update(codeKeypress(tea.KeyUp))
+	f.Update(codeKeypress(tea.KeySpace))
+	requireEqual(t, strings.Join(order, ","), "invoices,users,orders,audit")
+
+	// the cursor moves without the option once it's dropped.
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(keypress('J'))
+	requireEqual(t, strings.Join(order, ","), "invoices,orders,users,audit")
+	requireContains(t, ansi.Strip(f.View()), "> 3. users")
+
+	field.Top(2)
+	requireEqual(t, strings.Join(order, ","), "invoices,orders")
+	requireContains(t, ansi.Strip(field.View()), "── top 2 ──")
+	_, review := field.review()
+	requireEqual(t, review, "invoices, orders")
+
+	requireEqual(t, field.answer("audit").Error(), "list 2 options")
+	requireEqual(t, field.answer("audit, users"), nil)
+	requireEqual(t, strings.Join(order, ","), "audit,users")
+}
+
+func TestRankAccessible(t *testing.T) {
+	var out bytes.Buffer
+	order := []string{"orders"}
+	field := NewRank[string]().
+		Title("Migration order").
+		Options(NewOptions("users", "orders", "invoices")...).
+		Value(&order)
+	requireEqual(t, strings.Join(order, ","), "orders,users,invoices")
+
+	in := iotest.OneByteReader(strings.NewReader("3,3\n3,x\n3,2\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, out.String(), "1. orders")
+	requireContains(t, out.String(), "3 is listed twice")
+	requireContains(t, out.String(), `"x" is not a number`)
+	requireEqual(t, strings.Join(order, ","), "invoices,users,orders")
+}
+
+func TestPassword(t *testing.T) {
+	var password string
+	field := NewPassword().
+		Title("Password").
+		MinStrength(PasswordFair).
+		Value(&password)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f = typeText(f, "hunter2")
+	requireContains(t, ansi.Strip(field.View()), "━━━ ━━━ ━━━ ━━━ ━━━ weak")
+	requireContains(t, ansi.Strip(field.View()), "*******")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "too weak, must be at least fair")
+
+	// the password can be revealed while typing.
+	f = typeText(f, "-Tr0ub4dor&3")
+	f.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	requireContains(t, ansi.Strip(field.View()), "hunter2-Tr0ub4dor&3")
+	requireContains(t, ansi.Strip(field.View()), "very strong")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error(), nil)
+	requireContains(t, ansi.Strip(field.View()), "Confirm")
+
+	f = typeText(f, "hunter2")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "passwords don't match")
+	requireEqual(t, password, "")
+
+	f = typeText(f, "hunter2-Tr0ub4dor&3")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, password, "hunter2-Tr0ub4dor&3")
+
+	_, review := field.review()
+	requireEqual(t, review, strings.Repeat("*", len(password)))
+	requireEqual(t, field.answer("hunter2").Error(), "too weak, must be at least fair")
+
+	// going back from the confirmation works on the first field too.
+	field = NewPassword().Title("Password")
+	f = NewForm(NewGroup(field, NewInput()))
+	f.Update(f.Init())
+	f = typeText(f, "hunter2")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.confirming, true)
+	f.Update(tea.KeyPressMsg{Code: tea.KeyTab, Mod: tea.ModShift})
+	requireEqual(t, field.confirming, false)
+	requireEqual(t, field.entry.Focused(), true)
+}
+
+func TestPasswordSecret(t *testing.T) {
+	var secret []byte
+	field := NewPassword().
+		Title("Password").
+		MinStrength(PasswordFair).
+		SecretValue(&secret)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f = typeText(f, "hunter2")
+	requireContains(t, ansi.Strip(field.View()), "━━━ ━━━ ━━━ ━━━ ━━━ weak")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "too weak, must be at least fair")
+
+	// the text inputs only ever hold echo characters, even when revealing.
+	f = batchUpdate(f.Update(tea.PasteMsg{Content: "-Tr0ub4dor&3"})).(*Form)
+	f.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	requireEqual(t, field.entry.Value(), strings.Repeat("*", 19))
+	requireContains(t, ansi.Strip(field.View()), "very strong")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error(), nil)
+
+	f = typeText(f, "hunter2")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "passwords don't match")
+	requireEqual(t, field.confirmation.Value(), "")
+
+	f = typeText(f, "hunter2-Tr0ub4dor&3")
+	requireEqual(t, field.confirmation.Value(), strings.Repeat("*", 19))
+	buffer := field.secretEntry.runes[:cap(field.secretEntry.runes)]
+	batchUpdate(f.Update(codeKeypress(tea.KeyEnter)))
+	requireEqual(t, string(secret), "hunter2-Tr0ub4dor&3")
+	requireEqual(t, slices.ContainsFunc(buffer, func(r rune) bool { return r != 0 }), false)
+	requireEqual(t, string(field.GetValue().([]byte)), "hunter2-Tr0ub4dor&3")
+	_, review := field.review()
+	requireEqual(t, review, strings.Repeat("*", 19))
+
+	held := secret
+	requireEqual(t, field.answer("hunter2").Error(), "too weak, must be at least fair")
+	requireEqual(t, slices.ContainsFunc(held, func(b byte) bool { return b != 0 }), false)
+
+	// custom scorers get copies of the secret, zeroed once they return.
+	var scored [][]byte
+	secret = nil
+	field = NewPassword().
+		SecretValue(&secret).
+		Scorer(func(password []byte) PasswordStrength {
+			scored = append(scored, password)
+			return EntropyScorer(password)
+		})
+	f = NewForm(NewGroup(field))
+	f.Update(f.Init())
+	f = typeText(f, "hunter2")
+	requireContains(t, ansi.Strip(field.View()), "weak")
+	requireEqual(t, len(scored) > 0, true)
+	for _, password := range scored {
+		requireEqual(t, slices.ContainsFunc(password, func(b byte) bool { return b != 0 }), false)
+	}
+}
+
+func TestEntropyScorer(t *testing.T) {
+	for password, strength := range map[string]PasswordStrength{
+		"":                             PasswordVeryWeak,
+		"abcdefgh":                     PasswordVeryWeak,
+		"hunter2":                      PasswordWeak,
+		"Tr0ub4dor&3":                  PasswordStrong,
+		"correct horse battery staple": PasswordVeryStrong,
+	} {
+		requireEqual(t, EntropyScorer([]byte(password)), strength)
+	}
+}
+
+func TestPasswordAccessible(t *testing.T) {
+	t.Run("not a tty", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := NewPassword().RunAccessible(&out, bytes.NewReader(nil)); err == nil {
+			t.Error("expected it to error")
+		}
+	})
+
+	t.Run("is a tty", func(t *testing.T) {
+		var out bytes.Buffer
+		pty, err := xpty.NewPty(50, 30)
+		if err != nil {
+			t.Skipf("could not open pty: %v", err)
+		}
+		upty, ok := pty.(*xpty.UnixPty)
+		if !ok {
+			t.Skipf("test only works on unix")
+		}
+
+		var password string
+		field := NewPassword().Value(&password)
+
+		errs := make(chan error, 1)
+		go func() {
+			errs <- field.RunAccessible(&out, upty.Slave())
+		}()
+
+		_, _ = upty.Master().Write([]byte("Tr0ub4dor&3\nTr0ub4dor&4\nTr0ub4dor&3\nTr0ub4dor&3\n"))
+
+		if err := <-errs; err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+
+		requireContains(t, out.String(), "Strength: strong")
+		requireContains(t, out.String(), "passwords don't match")
+		requireEqual(t, password, "Tr0ub4dor&3")
+	})
+
+	t.Run("secret", func(t *testing.T) {
+		var out bytes.Buffer
+		pty, err := xpty.NewPty(50, 30)
+		if err != nil {
+			t.Skipf("could not open pty: %v", err)
+		}
+		upty, ok := pty.(*xpty.UnixPty)
+		if !ok {
+			t.Skipf("test only works on unix")
+		}
+
+		var secret []byte
+		field := NewPassword().SecretValue(&secret)
+
+		errs := make(chan error, 1)
+		go func() {
+			errs <- field.RunAccessible(&out, upty.Slave())
+		}()
+
+		_, _ = upty.Master().Write([]byte("Tr0ub4dor&3\nTr0ub4dor&4\nTr0ub4dor&3\nTr0ub4dor&3\n"))
+
+		if err := <-errs; err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+
+		requireContains(t, out.String(), "Strength: strong")
+		requireContains(t, out.String(), "passwords don't match")
+		requireEqual(t, string(secret), "Tr0ub4dor&3")
+	})
+}
+
+func TestTags(t *testing.T) {
+	var tags []string
+	field := NewTags().
+		Title("Labels").
+		Suggestions([]string{"bug", "feature", "docs"}).
+		Value(&tags)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f = typeText(f, "bug")
+	f.Update(codeKeypress(tea.KeyEnter))
+	f = typeText(f, "needs triage,")
+	requireEqual(t, strings.Join(tags, "|"), "bug|needs triage")
+	requireContains(t, ansi.Strip(f.View()), " bug   needs triage ")
+
+	// suggestions complete the tag being typed.
+	f = typeText(f, "fe")
+	f.Update(tea.KeyPressMsg{Code: 'e', Mod: tea.ModCtrl})
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, strings.Join(tags, "|"), "bug|needs triage|feature")
+
+	// duplicates and empty tags are ignored.
+	f = typeText(f, "bug,,")
+	requireEqual(t, strings.Join(tags, "|"), "bug|needs triage|feature")
+	requireEqual(t, field.textinput.Value(), "")
+
+	// backspace removes the last tag once the input is empty.
+	f = typeText(f, "x")
+	f.Update(codeKeypress(tea.KeyBackspace))
+	f.Update(codeKeypress(tea.KeyBackspace))
+	requireEqual(t, strings.Join(tags, "|"), "bug|needs triage")
+
+	field.Restrict(true)
+	f = typeText(f, "wontfix")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), `"wontfix" isn't a known tag`)
+
+	requireEqual(t, field.answer("Docs, bug"), nil)
+	requireEqual(t, strings.Join(tags, "|"), "docs|bug")
+	_, review := field.review()
+	requireEqual(t, review, "docs, bug")
+	requireEqual(t, field.answer("bug, nope").Error(), `"nope" isn't a known tag`)
+}
+
+func TestTagsAccessible(t *testing.T) {
+	var out bytes.Buffer
+	tags := []string{"bug"}
+	field := NewTags().
+		Title("Labels").
+		SuggestionsFunc(func() []string { return []string{"bug", "docs"} }, nil).
+		Restrict(true).
+		Value(&tags)
+
+	in := iotest.OneByteReader(strings.NewReader("bug, nope\nDOCS, bug, docs\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, out.String(), "Known tags: bug, docs")
+	requireContains(t, out.String(), `"nope" isn't a known tag`)
+	requireEqual(t, strings.Join(tags, "|"), "docs|bug")
+}
+
+func TestSelectOther(t *testing.T) {
+	// a value that isn't among the options is the answer of the "Other…" one.
+	pet := "Ferret"
+	field := NewSelect[string]().
+		Title("Pet").
+		Options(NewOptions("Cat", "Dog")...).
+		Other("Other:").
+		ValidateOther(ValidateMinLength(3)).
+		Value(&pet)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+	requireContains(t, ansi.Strip(f.View()), "> Other: Ferret")
+
+	f.Update(codeKeypress(tea.KeyUp))
+	requireEqual(t, pet, "Dog")
+	f.Update(codeKeypress(tea.KeyDown))
+	requireEqual(t, pet, "Ferret")
+
+	// choosing it edits its answer, where j, k and / are typed.
+	f.Update(codeKeypress(tea.KeyEnter))
+	for range "Ferret" {
+		f.Update(codeKeypress(tea.KeyBackspace))
+	}
+	f = typeText(f, "jk")
+	requireEqual(t, pet, "jk")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "input must be at least 3 characters long")
+
+	f = typeText(f, "/x")
+	_, cmd := f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error(), nil)
+	requireEqual(t, cmd != nil, true)
+	requireEqual(t, pet, "jk/x")
+	_, review := field.review()
+	requireEqual(t, review, "jk/x")
+
+	requireEqual(t, field.answer("Cat"), nil)
+	requireEqual(t, pet, "Cat")
+	requireEqual(t, field.answer("Hamster"), nil)
+	requireEqual(t, pet, "Hamster")
+	requireEqual(t, field.answer("Ox").Error(), "input must be at least 3 characters long")
+
+	// the validator is kept when it's set before the option.
+	field = NewSelect[string]().
+		Options(NewOptions("Cat", "Dog")...).
+		ValidateOther(ValidateMinLength(3)).
+		Other("Other:")
+	requireEqual(t, field.answer("Ox").Error(), "input must be at least 3 characters long")
+
+	// it's ignored for values other than strings.
+	numbers := NewSelect[int]().Options(NewOptions(1, 2)...).Other("Other:")
+	requireEqual(t, len(numbers.options.val), 2)
+}
+
+func TestMultiSelectOther(t *testing.T) {
+	pets := []string{"Dog", "Ferret"}
+	field := NewMultiSelect[string]().
+		Title("Pets").
+		Options(NewOptions("Cat", "Dog")...).
+		Other("Other:").
+		Height(6).
+		Value(&pets)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+	requireContains(t, ansi.Strip(f.View()), "✓ Other: Ferret")
+
+	// unselecting it drops its answer, selecting it again edits it.
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(keypress('x'))
+	requireEqual(t, strings.Join(pets, ","), "Dog")
+	f.Update(keypress('x'))
+	f = typeText(f, "s x")
+	requireEqual(t, strings.Join(pets, ","), "Dog,Ferrets x")
+
+	f.Update(tea.KeyPressMsg{Code: 'u', Mod: tea.ModCtrl})
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "input cannot be empty")
+	f = typeText(f, "Hamster")
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error(), nil)
+	requireEqual(t, strings.Join(pets, ","), "Dog,Hamster")
+	_, review := field.review()
+	requireEqual(t, review, "Dog, Hamster")
+
+	requireEqual(t, field.answer("Cat, Rabbit"), nil)
+	requireEqual(t, strings.Join(pets, ","), "Cat,Rabbit")
+	_, review = field.review()
+	requireEqual(t, review, "Cat, Rabbit")
+
+	// every answer that isn't among the options is kept.
+	requireEqual(t, field.answer("Rabbit, Hamster"), nil)
+	requireEqual(t, strings.Join(pets, ","), "Rabbit,Hamster")
+	_, review = field.review()
+	requireEqual(t, review, "Hamster, Rabbit")
+	requireEqual(t, field.answer("Rabbit, ").Error(), `"" is not one of the options`)
+
+	// and so are the values that aren't among the options.
+	pets = []string{"Ferret", "Cat", "Rabbit"}
+	field = NewMultiSelect[string]().
+		Options(NewOptions("Cat", "Dog")...).
+		Other("Other:").
+		Height(8).
+		Value(&pets)
+	f = NewForm(NewGroup(field))
+	f.Update(f.Init())
+	view := ansi.Strip(f.View())
+	requireContains(t, view, "✓ Rabbit")
+	requireContains(t, view, "✓ Other: Ferret")
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(keypress('x'))
+	f.Update(keypress('x'))
+	requireEqual(t, strings.Join(pets, ","), "Cat,Rabbit,Ferret")
+
+	// the validator is kept when it's set before the option.
+	field = NewMultiSelect[string]().
+		Options(NewOptions("Cat", "Dog")...).
+		ValidateOther(ValidateMinLength(3)).
+		Other("Other:")
+	requireEqual(t, field.answer([]string{"Cat", "Ox"}).Error(), "input must be at least 3 characters long")
+}
+
+func TestSelectOtherAccessible(t *testing.T) {
+	var out bytes.Buffer
+	var pet string
+	field := NewSelect[string]().
+		Title("Pet").
+		Options(NewOptions("Cat", "Dog")...).
+		Other("Other:").
+		Value(&pet)
+	in := iotest.OneByteReader(strings.NewReader("3\n\nFerret\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, out.String(), "3. Other:")
+	requireContains(t, out.String(), "input cannot be empty")
+	requireEqual(t, pet, "Ferret")
+
+	out.Reset()
+	pets := []string{"Rabbit"}
+	multi := NewMultiSelect[string]().
+		Title("Pets").
+		Options(NewOptions("Cat", "Dog")...).
+		Other("Other:").
+		Value(&pets)
+	in = iotest.OneByteReader(strings.NewReader("1\n3\n3\nFerret\n0\n"))
+	if err := multi.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, out.String(), "3. ✓ Other: Rabbit")
+	requireEqual(t, strings.Join(pets, ","), "Cat,Ferret")
+}
+
+func TestSelectDisabledOptions(t *testing.T) {
+	var plan string
+	field := NewSelect[string]().
+		Title("Plan").
+		Options(
+			NewOption("Free", "free").Disabled("current plan"),
+			NewOption("Pro", "pro").Description("$10/month"),
+			NewOption("Team", "team").Disabled(""),
+			NewOption("Enterprise", "enterprise"),
+		).
+		Value(&plan)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+	view := ansi.Strip(f.View())
+	requireContains(t, view, "> Pro $10/month")
+	requireContains(t, view, "Free (current plan)")
+
+	// the cursor starts on, and moves over, enabled options only.
+	requireEqual(t, plan, "pro")
+	f.Update(codeKeypress(tea.KeyDown))
+	requireEqual(t, plan, "enterprise")
+	f.Update(codeKeypress(tea.KeyDown))
+	requireEqual(t, plan, "pro")
+	f.Update(codeKeypress(tea.KeyUp))
+	requireEqual(t, plan, "enterprise")
+
+	requireEqual(t, field.answer("Free").Error(), "Free is unavailable: current plan")
+	requireEqual(t, field.answer("Team").Error(), "Team is unavailable")
+	requireEqual(t, plan, "enterprise")
+
+	// the cursor stays in view when it skips options out of it.
+	var size string
+	field = NewSelect[string]().
+		Options(
+			NewOption("S", "s"),
+			NewOption("M", "m"),
+			NewOption("L", "l").Disabled(""),
+			NewOption("XL", "xl").Disabled(""),
+			NewOption("XXL", "xxl"),
+		).
+		Height(2).
+		Value(&size)
+	f = NewForm(NewGroup(field))
+	f.Update(f.Init())
+	f.Update(codeKeypress(tea.KeyDown))
+	f.Update(codeKeypress(tea.KeyDown))
+	requireEqual(t, size, "xxl")
+	requireContains(t, ansi.Strip(f.View()), "> XXL")
+	f.Update(codeKeypress(tea.KeyUp))
+	requireEqual(t, size, "m")
+	requireContains(t, ansi.Strip(f.View()), "> M")
+}
+
+func TestMultiSelectDisabledOptions(t *testing.T) {
+	var plans []string
+	field := NewMultiSelect[string]().
+		Title("Plans").
+		Options(
+			NewOption("Free", "free").Disabled("current plan"),
+			NewOption("Pro", "pro").Description("$10/month"),
+			NewOption("Team", "team"),
+		).
+		Height(6).
+		Value(&plans)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+	requireContains(t, ansi.Strip(f.View()), "> • Pro $10/month")
+
+	// the cursor doesn't move onto the disabled option.
+	f.Update(codeKeypress(tea.KeyUp))
+	f.Update(keypress('x'))
+	requireEqual(t, strings.Join(plans, ","), "pro")
+
+	// selecting all leaves it out.
+	f.Update(tea.KeyPressMsg{Code: 'a', Mod: tea.ModCtrl})
+	requireEqual(t, strings.Join(plans, ","), "pro,team")
+	f.Update(tea.KeyPressMsg{Code: 'a', Mod: tea.ModCtrl})
+	requireEqual(t, len(plans), 0)
+
+	requireEqual(t, field.answer([]string{"Free", "Team"}).Error(), "Free is unavailable: current plan")
+	requireEqual(t, field.answer([]string{"Team"}), nil)
+	requireEqual(t, strings.Join(plans, ","), "team")
+}
+
+func TestDisabledOptionsAccessible(t *testing.T) {
+	var out bytes.Buffer
+	var plan string
+	field := NewSelect[string]().
+		Title("Plan").
+		Options(
+			NewOption("Free", "free").Disabled("current plan"),
+			NewOption("Pro", "pro").Description("$10/month"),
+		).
+		Value(&plan)
+	in := iotest.OneByteReader(strings.NewReader("1\n2\n"))
+	if err := field.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, out.String(), "1. Free (unavailable: current plan)")
+	requireContains(t, out.String(), "2. Pro - $10/month")
+	requireContains(t, out.String(), "Free is unavailable: current plan")
+	requireEqual(t, plan, "pro")
+
+	out.Reset()
+	var plans []string
+	multi := NewMultiSelect[string]().
+		Title("Plans").
+		Options(
+			NewOption("Free", "free").Disabled("current plan"),
+			NewOption("Pro", "pro"),
+		).
+		Value(&plans)
+	in = iotest.OneByteReader(strings.NewReader("1\n2\n0\n"))
+	if err := multi.RunAccessible(&out, in); err != nil {
+		t.Fatal(err)
+	}
+	requireContains(t, out.String(), "1.   Free (unavailable: current plan)")
+	requireContains(t, out.String(), "Free is unavailable: current plan")
+	requireEqual(t, strings.Join(plans, ","), "pro")
+
+	err := NewSelect[string]().Value(&plan).RunAccessible(io.Discard, strings.NewReader("1\n"))
+	requireEqual(t, err.Error(), "no options to select from")
+}
+
+// formProgram returns a new Form with a nil input and output, so it can be used as a test program.
+func formProgram() *Form {
+	return NewForm(NewGroup(NewInput().Title("Foo"))).
+		WithInput(nil).
+		WithOutput(io.Discard).
+		WithAccessible(false)
+}
+
+func batchUpdate(m Model, cmd tea.Cmd) Model {
+	if cmd == nil {
+		return m
+	}
+	msg := cmd()
+	m, cmd = m.Update(msg)
+	if cmd == nil {
+		return m
+	}
+	msg = cmd()
+	m, _ = m.Update(msg)
+	return m
+}
+
+func codeKeypress(r rune) tea.KeyPressMsg {
+	return tea.KeyPressMsg(tea.Key{
+		Code: r,
+	})
+}
+
+func keypress(r rune) tea.KeyPressMsg {
+	return tea.KeyPressMsg(tea.Key{
+		Text:        string(r),
+		Code:        r,
+		ShiftedCode: r,
+	})
+}
+
+func typeText[T Model](m T, s string) T {
+	var tm Model = m
+	for _, r := range s {
+		tm, _ = tm.Update(keypress(r))
+	}
+	return tm.(T)
+}
+
+func TestAccessibleForm(t *testing.T) {
+	var out bytes.Buffer
+
+	f := NewForm(
+		NewGroup(
+			NewInput().Title("Hello:"),
+		),
+	).
+		WithAccessible(true).
+		WithOutput(&out).
+		WithInput(strings.NewReader("carlos\n"))
+
+	if err := f.Run(); err != nil {
+		t.Error(err)
+	}
+
+	if !strings.Contains(out.String(), "Hello:") {
+		t.Error("invalid output:\n", out.String())
+	}
+}
+
+func TestAccessibleFields(t *testing.T) {
+	for name, test := range map[string]struct {
+		Field       Field
 		FieldFn     func() Field
 		Input       string
 		CheckOutput func(tb testing.TB, output string)
@@ -1670,6 +3846,99 @@ func TestInputPasswordAccessible(t *testing.T) {
 	})
 }
 
+func TestInputSecret(t *testing.T) {
+	var secret []byte
+	field := NewInput().
+		Key("token").
+		Title("Token").
+		EchoMode(EchoModePassword).
+		SecretValue(&secret).
+		ValidateSecret(func(b []byte) error {
+			if len(b) == 0 {
+				return errors.New("token is required")
+			}
+			return nil
+		})
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f.Update(codeKeypress(tea.KeyEnter))
+	requireEqual(t, field.Error().Error(), "token is required")
+
+	f = typeText(f, "s3cr3t")
+	f.Update(codeKeypress(tea.KeyBackspace))
+	f = typeText(f, "!")
+	// the text input only ever holds echo characters.
+	requireEqual(t, field.textinput.Value(), "******")
+	requireContains(t, ansi.Strip(f.View()), "******")
+
+	buffer := field.secretValue.runes[:cap(field.secretValue.runes)]
+	batchUpdate(f.Update(codeKeypress(tea.KeyEnter)))
+	requireEqual(t, string(secret), "s3cr3!")
+	requireEqual(t, slices.ContainsFunc(buffer, func(r rune) bool { return r != 0 }), false)
+	requireEqual(t, string(field.GetValue().([]byte)), "s3cr3!")
+	requireEqual(t, string(f.Get("token").([]byte)), "s3cr3!")
+	token, err := GetAs[[]byte](f, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireEqual(t, string(token), "s3cr3!")
+	_, review := field.review()
+	requireEqual(t, review, "******")
+
+	held := secret
+	Zero(secret)
+	requireEqual(t, string(held), "\x00\x00\x00\x00\x00\x00")
+
+	// pasted secrets never reach the text input either.
+	secret = nil
+	field = NewInput().Title("Token").EchoMode(EchoModePassword).SecretValue(&secret)
+	f = NewForm(NewGroup(field, NewInput()))
+	f.Update(f.Init())
+	f = batchUpdate(f.Update(tea.PasteMsg{Content: "p4st3d\n"})).(*Form)
+	requireEqual(t, field.textinput.Value(), "******")
+	f.Update(NextField())
+	requireEqual(t, string(secret), "p4st3d")
+}
+
+func TestInputSecretAccessible(t *testing.T) {
+	pty, err := xpty.NewPty(50, 30)
+	if err != nil {
+		t.Skipf("could not open pty: %v", err)
+	}
+	upty, ok := pty.(*xpty.UnixPty)
+	if !ok {
+		t.Skipf("test only works on unix")
+	}
+
+	var out bytes.Buffer
+	secret := []byte("old")
+	old := secret
+	field := NewInput().
+		EchoMode(EchoModePassword).
+		SecretValue(&secret).
+		ValidateSecret(func(b []byte) error {
+			if len(b) == 0 {
+				return errors.New("token is required")
+			}
+			return nil
+		})
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- field.RunAccessible(&out, upty.Slave())
+	}()
+
+	_, _ = upty.Master().Write([]byte("\ns3cr3t\n"))
+
+	if err := <-errs; err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	requireContains(t, out.String(), "token is required")
+	requireEqual(t, string(secret), "s3cr3t")
+	requireEqual(t, string(old), "\x00\x00\x00")
+}
+
 func requireEqual[T comparable](tb testing.TB, a, b T) {
 	tb.Helper()
 	if a != b {
@@ -0,0 +1,373 @@
+// Package huhtest drives forms headlessly in tests.
+//
+// A [Driver] feeds key presses into a [huh.Form] and runs the resulting
+// commands until the form settles, without a terminal or a tea.Program:
+//
+//	d := huhtest.New(t, form)
+//	d.Type("Frank").Press("enter")
+//	d.SelectOption("Pizza").Press("enter")
+//	d.AssertValue("name", "Frank")
+//	d.RequireView()
+//
+// The driver waits for every command to return, however long it takes.
+// Timers, such as cursor blinks and spinner ticks, are dropped without being
+// run, so that the driver never waits on them.
+package huhtest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"charm.land/bubbles/v2/cursor"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+const (
+	// DefaultWidth is the width of the window the driver reports to forms.
+	DefaultWidth = 80
+	// DefaultHeight is the height of the window the driver reports to forms.
+	DefaultHeight = 24
+
+	// maxMessages bounds the messages processed per action, to catch forms
+	// that never settle.
+	maxMessages = 10_000
+)
+
+// Driver drives a form in tests.
+type Driver struct {
+	tb   testing.TB
+	form *huh.Form
+}
+
+// New initializes the form and returns a driver for it. The form is sent a
+// window size of [DefaultWidth] by [DefaultHeight].
+func New(tb testing.TB, form *huh.Form) *Driver {
+	tb.Helper()
+	d := &Driver{tb: tb, form: form}
+	d.run(form.Init())
+	d.Send(tea.WindowSizeMsg{Width: DefaultWidth, Height: DefaultHeight})
+	return d
+}
+
+// Form returns the driven form.
+func (d *Driver) Form() *huh.Form {
+	return d.form
+}
+
+// Resize sends a window size to the form.
+func (d *Driver) Resize(width, height int) *Driver {
+	d.tb.Helper()
+	return d.Send(tea.WindowSizeMsg{Width: width, Height: height})
+}
+
+// Send sends a message to the form and runs the resulting commands until the
+// form settles.
+func (d *Driver) Send(msg tea.Msg) *Driver {
+	d.tb.Helper()
+	d.update(msg)
+	return d
+}
+
+// Type types the given text, one key press per rune. Newlines are typed as
+// enter.
+func (d *Driver) Type(text string) *Driver {
+	d.tb.Helper()
+	for _, r := range text {
+		switch r {
+		case '\n':
+			d.update(tea.KeyPressMsg{Code: tea.KeyEnter})
+		case ' ':
+			d.update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+		default:
+			d.update(tea.KeyPressMsg{Code: r, Text: string(r)})
+		}
+	}
+	return d
+}
+
+// Press presses the named keys in order. Keys are named the way key bindings
+// name them, such as "enter", "shift+tab", "ctrl+c", "down" or "x".
+func (d *Driver) Press(keys ...string) *Driver {
+	d.tb.Helper()
+	for _, k := range keys {
+		msg, err := ParseKey(k)
+		if err != nil {
+			d.tb.Fatalf("huhtest: %v", err)
+		}
+		d.update(msg)
+	}
+	return d
+}
+
+// hoverer is implemented by fields with a list of options, such as
+// [huh.Select] and [huh.MultiSelect].
+type hoverer interface {
+	HoveredKey() (string, bool)
+}
+
+// SelectOption moves the cursor of the focused field to the option with the
+// given label. On a [huh.MultiSelect], the option is then toggled.
+//
+// It fails the test if the focused field has no options or no option has the
+// label.
+func (d *Driver) SelectOption(label string) *Driver {
+	d.tb.Helper()
+	field := d.Focused()
+	h, ok := field.(hoverer)
+	if !ok {
+		d.tb.Fatalf("huhtest: focused field %T has no options", field)
+		return d
+	}
+
+	d.Press("home")
+	first, ok := h.HoveredKey()
+	for ok && first != label {
+		d.Press("down")
+		next, _ := h.HoveredKey()
+		if next == first {
+			// the cursor wrapped around, or can't move any further.
+			ok = false
+			break
+		}
+		first = next
+	}
+	if !ok {
+		d.tb.Fatalf("huhtest: no option %q in focused field", label)
+		return d
+	}
+	if isMultiSelect(field) {
+		d.Press("x")
+	}
+	return d
+}
+
+// isMultiSelect reports whether the field is a [huh.MultiSelect] of any type.
+func isMultiSelect(field huh.Field) bool {
+	t := reflect.TypeOf(field)
+	return t.Kind() == reflect.Pointer && strings.HasPrefix(t.Elem().Name(), "MultiSelect[")
+}
+
+// NextGroup moves the form to the next group, submitting it if the current
+// group is the last one.
+func (d *Driver) NextGroup() *Driver {
+	d.tb.Helper()
+	d.run(d.form.NextGroup())
+	return d
+}
+
+// PrevGroup moves the form to the previous group.
+func (d *Driver) PrevGroup() *Driver {
+	d.tb.Helper()
+	d.run(d.form.PrevGroup())
+	return d
+}
+
+// Focused returns the focused field, failing the test if there is none.
+func (d *Driver) Focused() huh.Field {
+	d.tb.Helper()
+	field := d.form.GetFocusedField()
+	if field == nil {
+		d.tb.Fatal("huhtest: no focused field")
+	}
+	return field
+}
+
+// View returns the rendered form without styling.
+func (d *Driver) View() string {
+	return ansi.Strip(d.form.View())
+}
+
+// AssertState checks the state of the form.
+func (d *Driver) AssertState(want huh.FormState) *Driver {
+	d.tb.Helper()
+	if d.form.State != want {
+		d.tb.Errorf("huhtest: form state is %v, want %v", d.form.State, want)
+	}
+	return d
+}
+
+// AssertFocused checks the key of the focused field.
+func (d *Driver) AssertFocused(key string) *Driver {
+	d.tb.Helper()
+	if got := d.Focused().GetKey(); got != key {
+		d.tb.Errorf("huhtest: focused field is %q, want %q", got, key)
+	}
+	return d
+}
+
+// AssertValue checks the result of [huh.Form.Get] for the given key.
+func (d *Driver) AssertValue(key string, want any) *Driver {
+	d.tb.Helper()
+	if got := d.form.Get(key); !reflect.DeepEqual(got, want) {
+		d.tb.Errorf("huhtest: %q is %#v, want %#v", key, got, want)
+	}
+	return d
+}
+
+// RequireView compares the rendered form, without styling, to the golden
+// file testdata/<test name>.golden. Run the tests with -update to update the
+// golden files.
+func (d *Driver) RequireView() *Driver {
+	d.tb.Helper()
+	golden.RequireEqual(d.tb, d.View())
+	return d
+}
+
+// update sends a message to the form and runs the resulting commands.
+func (d *Driver) update(msg tea.Msg) {
+	d.tb.Helper()
+	_, cmd := d.form.Update(msg)
+	d.run(cmd)
+}
+
+// run runs the command, and the commands resulting from the messages it
+// produces, until there are none left.
+func (d *Driver) run(cmd tea.Cmd) {
+	d.tb.Helper()
+	queue := []tea.Cmd{cmd}
+	for n := 0; len(queue) > 0; n++ {
+		if n > maxMessages {
+			d.tb.Fatal("huhtest: form did not settle")
+		}
+		cmd, queue = queue[0], queue[1:]
+		if cmd == nil || isTimer(cmd) {
+			continue
+		}
+		msg := cmd()
+		if cmds, ok := subcommands(msg); ok {
+			queue = append(cmds, queue...)
+			continue
+		}
+		if msg == nil {
+			continue
+		}
+		_, next := d.form.Update(msg)
+		queue = append(queue, next)
+	}
+}
+
+// timers are the name prefixes of the commands that wait for a timer: cursor
+// blinks, and the ticks of spinners and other animations.
+var timers = []string{
+	reflect.TypeFor[cursor.Model]().PkgPath() + ".(*Model).Blink.",
+	reflect.TypeFor[tea.Program]().PkgPath() + ".Tick.",
+	reflect.TypeFor[tea.Program]().PkgPath() + ".Every.",
+}
+
+// isTimer reports whether the command waits for a timer.
+func isTimer(cmd tea.Cmd) bool {
+	fn := runtime.FuncForPC(reflect.ValueOf(cmd).Pointer())
+	if fn == nil {
+		return false
+	}
+	name := fn.Name()
+	return slices.ContainsFunc(timers, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// subcommands returns the commands of batched and sequenced commands.
+func subcommands(msg tea.Msg) ([]tea.Cmd, bool) {
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		return batch, true
+	}
+	// sequences are unexported, but are slices of commands too.
+	v := reflect.ValueOf(msg)
+	if !v.IsValid() || v.Kind() != reflect.Slice || v.Type().Elem() != reflect.TypeFor[tea.Cmd]() {
+		return nil, false
+	}
+	cmds := make([]tea.Cmd, v.Len())
+	for i := range cmds {
+		cmds[i], _ = v.Index(i).Interface().(tea.Cmd)
+	}
+	return cmds, true
+}
+
+// keyNames maps key names to key codes.
+var keyNames = map[string]rune{
+	"enter":     tea.KeyEnter,
+	"tab":       tea.KeyTab,
+	"backspace": tea.KeyBackspace,
+	"esc":       tea.KeyEscape,
+	"escape":    tea.KeyEscape,
+	"space":     tea.KeySpace,
+	"up":        tea.KeyUp,
+	"down":      tea.KeyDown,
+	"left":      tea.KeyLeft,
+	"right":     tea.KeyRight,
+	"home":      tea.KeyHome,
+	"end":       tea.KeyEnd,
+	"pgup":      tea.KeyPgUp,
+	"pgdown":    tea.KeyPgDown,
+	"insert":    tea.KeyInsert,
+	"delete":    tea.KeyDelete,
+}
+
+// keyMods maps modifier names to key modifiers.
+var keyMods = map[string]tea.KeyMod{
+	"ctrl":  tea.ModCtrl,
+	"alt":   tea.ModAlt,
+	"shift": tea.ModShift,
+	"meta":  tea.ModMeta,
+	"super": tea.ModSuper,
+	"hyper": tea.ModHyper,
+}
+
+// ParseKey parses a named key, such as "enter", "shift+tab", "ctrl+c" or
+// "x", into a key press.
+func ParseKey(s string) (tea.KeyPressMsg, error) {
+	if s == "" {
+		return tea.KeyPressMsg{}, errors.New("empty key")
+	}
+	name := s
+	var mod tea.KeyMod
+	// the last part is the key itself, which may be a "+".
+	if i := strings.LastIndex(s[:len(s)-1], "+"); i >= 0 {
+		for _, m := range strings.Split(s[:i], "+") {
+			km, ok := keyMods[m]
+			if !ok {
+				return tea.KeyPressMsg{}, fmt.Errorf("unknown modifier %q in key %q", m, s)
+			}
+			mod |= km
+		}
+		name = s[i+1:]
+	}
+
+	if code, ok := keyNames[name]; ok {
+		msg := tea.KeyPressMsg{Code: code, Mod: mod}
+		if code == tea.KeySpace && mod == 0 {
+			msg.Text = " "
+		}
+		return msg, nil
+	}
+	if code, ok := functionKey(name); ok {
+		return tea.KeyPressMsg{Code: code, Mod: mod}, nil
+	}
+
+	runes := []rune(name)
+	if len(runes) != 1 {
+		return tea.KeyPressMsg{}, fmt.Errorf("unknown key %q", s)
+	}
+	msg := tea.KeyPressMsg{Code: runes[0], Mod: mod}
+	if mod == 0 {
+		msg.Text = name
+	}
+	return msg, nil
+}
+
+// functionKey parses function keys, f1 to f20.
+func functionKey(name string) (rune, bool) {
+	var n int
+	if _, err := fmt.Sscanf(name, "f%d", &n); err != nil || fmt.Sprintf("f%d", n) != name || n < 1 || n > 20 {
+		return 0, false
+	}
+	return tea.KeyF1 + rune(n-1), true
+}
@@ -0,0 +1,113 @@
+package huhtest
+
+import (
+	"testing"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2"
+)
+
+func newTestForm() *huh.Form {
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().Key("name").Title("Name"),
+			huh.NewSelect[string]().Key("food").Title("Food").
+				Options(huh.NewOptions("Pizza", "Burger", "Salad")...),
+		),
+		huh.NewGroup(
+			huh.NewMultiSelect[string]().Key("toppings").Title("Toppings").
+				Options(huh.NewOptions("Cheese", "Olives", "Ham")...),
+			huh.NewConfirm().Key("sure").Title("Sure?"),
+		),
+	)
+}
+
+func TestDriver(t *testing.T) {
+	d := New(t, newTestForm())
+	d.AssertFocused("name")
+	d.Type("Frank").Press("enter")
+	d.AssertFocused("food")
+	d.SelectOption("Salad").Press("enter")
+	d.AssertFocused("toppings")
+	d.SelectOption("Ham").SelectOption("Cheese").Press("enter")
+	d.AssertFocused("sure")
+	d.Press("left").Press("enter")
+	d.AssertState(huh.StateCompleted)
+
+	d.AssertValue("name", "Frank")
+	d.AssertValue("food", "Salad")
+	d.AssertValue("toppings", []string{"Cheese", "Ham"})
+	d.AssertValue("sure", true)
+}
+
+func TestDriverGroups(t *testing.T) {
+	d := New(t, newTestForm())
+	d.NextGroup().AssertFocused("toppings")
+	d.PrevGroup().AssertFocused("name")
+	d.Press("ctrl+c").AssertState(huh.StateAborted)
+}
+
+func TestDriverView(t *testing.T) {
+	d := New(t, newTestForm())
+	d.Type("Frank").Press("tab", "down")
+	d.RequireView()
+}
+
+func TestDriverSlowCommands(t *testing.T) {
+	form := huh.NewForm(huh.NewGroup(
+		huh.NewSelect[string]().Key("food").Title("Food").
+			OptionsFunc(func() []huh.Option[string] {
+				time.Sleep(100 * time.Millisecond)
+				return huh.NewOptions("Pizza", "Burger", "Salad")
+			}, nil),
+	))
+	d := New(t, form)
+	d.SelectOption("Burger").Press("enter")
+	d.AssertState(huh.StateCompleted)
+	d.AssertValue("food", "Burger")
+}
+
+func TestIsTimer(t *testing.T) {
+	input := textinput.New()
+	if !isTimer(input.Focus()) {
+		t.Error("cursor blinks are timers")
+	}
+	if !isTimer(tea.Tick(time.Second, nil)) || !isTimer(tea.Every(time.Second, nil)) {
+		t.Error("ticks are timers")
+	}
+	if isTimer(tea.RequestWindowSize) {
+		t.Error("window size requests aren't timers")
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	for _, tc := range []string{
+		"enter", "shift+tab", "ctrl+c", "alt+enter", "down", "x", "X", "+",
+		"ctrl++", "space", "f5", "ctrl+shift+up",
+	} {
+		msg, err := ParseKey(tc)
+		if err != nil {
+			t.Errorf("ParseKey(%q): %v", tc, err)
+			continue
+		}
+		if got := msg.String(); got != tc {
+			t.Errorf("ParseKey(%q).String() = %q", tc, got)
+		}
+	}
+
+	for _, tc := range []string{"", "enterr", "hyperr+a", "f0"} {
+		if _, err := ParseKey(tc); err == nil {
+			t.Errorf("ParseKey(%q): expected an error", tc)
+		}
+	}
+
+	msg, _ := ParseKey("a")
+	if msg.Code != 'a' || msg.Text != "a" {
+		t.Errorf("ParseKey(%q) = %#v", "a", msg)
+	}
+	if msg, _ := ParseKey("space"); msg != (tea.KeyPressMsg{Code: tea.KeySpace, Text: " "}) {
+		t.Errorf("ParseKey(%q) = %#v", "space", msg)
+	}
+}
@@ -60,6 +60,43 @@ func PromptInt(
 	return choice
 }
 
+// PromptNumber prompts a user for a number, integer or float, parsed with
+// parse and checked with validator.
+//
+// Given invalid input (non-numbers, numbers the validator rejects, or no input
+// when the default is rejected), the user will continue to be reprompted until
+// a valid input is given, ensuring that the return value is always valid.
+func PromptNumber[T int | int64 | float64](
+	out io.Writer,
+	in io.Reader,
+	prompt string,
+	defaultValue *T,
+	parse func(string) (T, error),
+	validator func(T) error,
+) T {
+	validNumber := func(s string) error {
+		if strings.TrimSpace(s) == "" && defaultValue != nil {
+			// the default is checked too, it may not be valid.
+			return validator(*defaultValue)
+		}
+		n, err := parse(strings.TrimSpace(s))
+		if err != nil {
+			return errors.New("Invalid: must be a number") //nolint:staticcheck
+		}
+		return validator(n)
+	}
+
+	input := PromptString(
+		out,
+		in,
+		prompt,
+		ptrToStr(defaultValue, func(n T) string { return fmt.Sprint(n) }),
+		validNumber,
+	)
+	n, _ := parse(input)
+	return n
+}
+
 func parseBool(s string) (bool, error) {
 	s = strings.ToLower(s)
 
@@ -126,6 +163,30 @@ func PromptPassword(
 	}
 }
 
+// PromptSecret prompts a user for a secret, like PromptPassword, but returns
+// it as bytes so it can be zeroed once used. Rejected inputs are zeroed.
+func PromptSecret(
+	out io.Writer,
+	in uintptr,
+	prompt string,
+	validator func(input []byte) error,
+) ([]byte, error) {
+	for {
+		_, _ = fmt.Fprint(out, prompt)
+		secret, err := term.ReadPassword(in)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+		_, _ = fmt.Fprintln(out)
+		if err := validator(secret); err != nil {
+			clear(secret)
+			_, _ = fmt.Fprintln(out, err)
+			continue
+		}
+		return secret, nil
+	}
+}
+
 // PromptString prompts a user for a string value and validates it against a
 // validator function. It re-prompts the user until a valid input is given.
 func PromptString(
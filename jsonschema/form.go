@@ -0,0 +1,234 @@
+package jsonschema
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"charm.land/huh/v2"
+)
+
+// Form is a [huh.Form] built from a schema.
+//
+// Use [Form.Results] once the form has been completed to retrieve the values
+// entered by the user.
+type Form struct {
+	*huh.Form
+
+	schema *Schema
+	values []value
+}
+
+// value is a form result bound to a schema property.
+type value struct {
+	name string
+
+	// get returns the property's value and whether it should be part of the
+	// results.
+	get func() (any, bool)
+}
+
+// NewForm returns a new form prompting for each of the schema's properties.
+//
+// Strings become inputs, numbers and integers become inputs that only accept
+// numeric values, booleans become confirms, enums become selects and arrays of
+// enums become multi-selects. The schema's constraints are enforced using the
+// fields' validation functions and defaults are used as initial values.
+func NewForm(schema *Schema) (*Form, error) {
+	if schema.Type != "" && schema.Type != "object" {
+		return nil, fmt.Errorf("jsonschema: schema must be of type object, got %q", schema.Type)
+	}
+
+	f := &Form{schema: schema}
+	fields := make([]huh.Field, 0, len(schema.Properties))
+	for _, name := range schema.PropertyNames() {
+		field, v, err := newField(name, schema.Properties[name], schema.IsRequired(name))
+		if err != nil {
+			return nil, fmt.Errorf("jsonschema: property %q: %w", name, err)
+		}
+		fields = append(fields, field)
+		f.values = append(f.values, v)
+	}
+
+	f.Form = huh.NewForm(
+		huh.NewGroup(fields...).
+			Title(schema.Title).
+			Description(schema.Description),
+	)
+	return f, nil
+}
+
+// Results returns the values entered by the user keyed by property name.
+//
+// Optional properties left empty are omitted, so the results validate
+// against the schema.
+func (f *Form) Results() map[string]any {
+	results := make(map[string]any, len(f.values))
+	for _, v := range f.values {
+		if val, ok := v.get(); ok {
+			results[v.name] = val
+		}
+	}
+	return results
+}
+
+// Schema returns the schema the form was built from.
+func (f *Form) Schema() *Schema {
+	return f.schema
+}
+
+func newField(name string, s *Schema, required bool) (huh.Field, value, error) {
+	title := cmp.Or(s.Title, name)
+	v := value{name: name}
+	if err := s.compile(); err != nil {
+		return nil, v, err
+	}
+
+	switch {
+	case len(s.Enum) > 0 && s.Type != "array":
+		options := enumOptions(s)
+		var selected any
+		if s.Default != nil {
+			selected = normalize(s, s.Default)
+		}
+		v.get = func() (any, bool) { return selected, true }
+		field := huh.NewSelect[any]().
+			Key(name).
+			Title(title).
+			Description(s.Description).
+			Options(options...).
+			Value(&selected)
+		return field, v, nil
+
+	case s.Type == "string":
+		str, _ := s.Default.(string)
+		v.get = func() (any, bool) { return str, str != "" || required }
+		field := huh.NewInput().
+			Key(name).
+			Title(title).
+			Description(s.Description).
+			Validate(func(str string) error {
+				if str == "" {
+					if required {
+						return fmt.Errorf("%s is required", title)
+					}
+					return nil
+				}
+				return s.check(str)
+			}).
+			Value(&str)
+		return field, v, nil
+
+	case s.Type == "integer", s.Type == "number":
+		var str string
+		switch d := s.Default.(type) {
+		case nil:
+		case float64:
+			// JSON numbers are float64s, which Sprint may format with an
+			// exponent, such as 1e+06.
+			str = strconv.FormatFloat(d, 'f', -1, 64)
+		default:
+			str = fmt.Sprint(d)
+		}
+		v.get = func() (any, bool) {
+			n, err := parseNumber(s, str)
+			return n, err == nil && str != ""
+		}
+		field := huh.NewInput().
+			Key(name).
+			Title(title).
+			Description(s.Description).
+			Validate(func(str string) error {
+				if strings.TrimSpace(str) == "" {
+					if required {
+						return fmt.Errorf("%s is required", title)
+					}
+					return nil
+				}
+				n, err := parseNumber(s, str)
+				if err != nil {
+					return err
+				}
+				return s.check(n)
+			}).
+			Value(&str)
+		return field, v, nil
+
+	case s.Type == "boolean":
+		b, _ := s.Default.(bool)
+		v.get = func() (any, bool) { return b, true }
+		field := huh.NewConfirm().
+			Key(name).
+			Title(title).
+			Description(s.Description).
+			Value(&b)
+		return field, v, nil
+
+	case s.Type == "array":
+		if s.Items == nil || len(s.Items.Enum) == 0 {
+			return nil, v, errors.New("only arrays of enums are supported")
+		}
+		var selected []any
+		if defaults, ok := s.Default.([]any); ok {
+			for _, d := range defaults {
+				selected = append(selected, normalize(s.Items, d))
+			}
+		}
+		v.get = func() (any, bool) {
+			if selected == nil {
+				return []any{}, required
+			}
+			return selected, len(selected) > 0 || required
+		}
+		field := huh.NewMultiSelect[any]().
+			Key(name).
+			Title(title).
+			Description(s.Description).
+			Options(enumOptions(s.Items)...).
+			Validate(func(values []any) error {
+				return s.check(values)
+			}).
+			Value(&selected)
+		return field, v, nil
+
+	default:
+		return nil, v, fmt.Errorf("unsupported type %q", s.Type)
+	}
+}
+
+func enumOptions(s *Schema) []huh.Option[any] {
+	options := make([]huh.Option[any], 0, len(s.Enum))
+	for _, e := range s.Enum {
+		options = append(options, huh.NewOption(fmt.Sprint(e), normalize(s, e)))
+	}
+	return options
+}
+
+// normalize converts JSON numbers to ints for integer schemas.
+func normalize(s *Schema, v any) any {
+	if s.Type != "integer" {
+		return v
+	}
+	if n, ok := toFloat(v); ok {
+		return int(n)
+	}
+	return v
+}
+
+func parseNumber(s *Schema, str string) (any, error) {
+	str = strings.TrimSpace(str)
+	if s.Type == "integer" {
+		n, err := strconv.Atoi(str)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", str)
+		}
+		return n, nil
+	}
+	n, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a number", str)
+	}
+	return n, nil
+}
@@ -0,0 +1,174 @@
+package jsonschema
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2"
+)
+
+const testSchema = `{
+	"type": "object",
+	"title": "Service",
+	"required": ["name", "replicas"],
+	"properties": {
+		"name": {"type": "string", "minLength": 3, "pattern": "^[a-z-]+$", "description": "Service name"},
+		"replicas": {"type": "integer", "minimum": 1, "maximum": 5, "default": 2},
+		"ratio": {"type": "number"},
+		"public": {"type": "boolean", "default": true},
+		"tier": {"type": "string", "enum": ["free", "pro"], "default": "pro"},
+		"regions": {"type": "array", "items": {"type": "string", "enum": ["eu", "us", "ap"]}, "default": ["us"]}
+	}
+}`
+
+func TestParseKeepsPropertyOrder(t *testing.T) {
+	s, err := Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Join(s.PropertyNames(), ",")
+	if want := "name,replicas,ratio,public,tier,regions"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestNewForm(t *testing.T) {
+	s, err := Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := NewForm(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Defaults are used as initial values and empty optional values are
+	// omitted.
+	results := f.Results()
+	if _, ok := results["ratio"]; ok {
+		t.Error("expected empty optional ratio to be omitted")
+	}
+	if results["replicas"] != 2 || results["public"] != true || results["tier"] != "pro" {
+		t.Errorf("unexpected defaults: %v", results)
+	}
+	if err := s.Validate(results); err == nil || !strings.Contains(err.Error(), "name") {
+		t.Errorf("expected a name error, got %v", err)
+	}
+
+	f.Update(f.Init()())
+	for _, r := range "web-app" {
+		f.Update(tea.KeyPressMsg(tea.Key{Text: string(r), Code: r}))
+	}
+	f.GetFocusedField().Blur()
+
+	results = f.Results()
+	if results["name"] != "web-app" {
+		t.Errorf("expected name to be web-app, got %v", results["name"])
+	}
+	if err := s.Validate(results); err != nil {
+		t.Errorf("expected results to validate, got %v", err)
+	}
+}
+
+func TestFieldValidation(t *testing.T) {
+	s, err := Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name, value string
+		ok          bool
+	}{
+		{"name", "", false},
+		{"name", "ab", false},
+		{"name", "Web", false},
+		{"name", "web", true},
+		{"replicas", "", false},
+		{"replicas", "1.5", false},
+		{"replicas", "9", false},
+		{"replicas", "3", true},
+		{"ratio", "", true},
+		{"ratio", "abc", false},
+		{"ratio", "0.5", true},
+	} {
+		prop := *s.Properties[tc.name]
+		prop.Default = nil
+		field, _, err := newField(tc.name, &prop, s.IsRequired(tc.name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		input := field.(*huh.Input)
+		input.Focus()
+		for _, r := range tc.value {
+			input.Update(tea.KeyPressMsg(tea.Key{Text: string(r), Code: r}))
+		}
+		input.Blur()
+		if ok := input.Error() == nil; ok != tc.ok {
+			t.Errorf("%s=%q: expected valid=%v, got error %v", tc.name, tc.value, tc.ok, input.Error())
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	s, err := Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Validate(map[string]any{
+		"name":     "ok",
+		"replicas": 2.5,
+		"tier":     "enterprise",
+		"regions":  []any{"eu", "mars"},
+	})
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, want := range []string{"name:", "replicas:", "tier:", "regions: item 1"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %q", want, err)
+		}
+	}
+}
+
+func TestUnsupportedType(t *testing.T) {
+	s, err := Parse([]byte(`{"properties": {"nested": {"type": "object"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewForm(s); err == nil || !strings.Contains(err.Error(), "nested") {
+		t.Errorf("expected an unsupported type error, got %v", err)
+	}
+}
+
+func TestLargeDefault(t *testing.T) {
+	s, err := Parse([]byte(`{"properties": {
+		"size": {"type": "integer", "default": 1000000},
+		"limit": {"type": "number", "default": 0.00001}
+	}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := NewForm(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := f.Results()
+	if results["size"] != 1000000 || results["limit"] != 0.00001 {
+		t.Errorf("expected the defaults, got %v", results)
+	}
+}
+
+func TestInvalidPattern(t *testing.T) {
+	s, err := Parse([]byte(`{"properties": {"name": {"type": "string", "pattern": "[a-"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewForm(s); err == nil || !strings.Contains(err.Error(), `property "name": invalid pattern`) {
+		t.Errorf("expected an invalid pattern error, got %v", err)
+	}
+	if err := s.Validate(map[string]any{}); err == nil || !strings.Contains(err.Error(), "name: invalid pattern") {
+		t.Errorf("expected an invalid pattern error, got %v", err)
+	}
+}
@@ -0,0 +1,289 @@
+// Package jsonschema builds forms from JSON Schema documents.
+//
+// Only a subset of JSON Schema (draft 2020-12) is supported: string, number,
+// integer and boolean properties, enums, arrays of enums, and the minLength,
+// maxLength, pattern, minimum, maximum, required, default and description
+// keywords.
+//
+//	schema, err := jsonschema.Parse(data)
+//	if err != nil { ... }
+//	form, err := jsonschema.NewForm(schema)
+//	if err != nil { ... }
+//	if err := form.Run(); err != nil { ... }
+//	results := form.Results()
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"unicode/utf8"
+)
+
+// Schema is a JSON Schema document, or a subschema of one.
+type Schema struct {
+	Type        string             `json:"type,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	Enum        []any              `json:"enum,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	MinLength   *int               `json:"minLength,omitempty"`
+	MaxLength   *int               `json:"maxLength,omitempty"`
+	Pattern     string             `json:"pattern,omitempty"`
+	Minimum     *float64           `json:"minimum,omitempty"`
+	Maximum     *float64           `json:"maximum,omitempty"`
+	Default     any                `json:"default,omitempty"`
+
+	// order is the order in which properties appear in the document.
+	order []string
+	// pattern is the compiled Pattern.
+	pattern *regexp.Regexp
+}
+
+// Parse parses a JSON Schema document.
+func Parse(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("jsonschema: %w", err)
+	}
+	return &s, nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It keeps track of the order of
+// the properties so forms are displayed in the same order as the document.
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type schema Schema
+	if err := json.Unmarshal(data, (*schema)(s)); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	var raw struct {
+		Properties json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err //nolint:wrapcheck
+	}
+	if len(raw.Properties) == 0 {
+		return nil
+	}
+	order, err := objectKeys(raw.Properties)
+	if err != nil {
+		return err
+	}
+	s.order = order
+	return nil
+}
+
+// objectKeys returns the keys of a JSON object in the order they appear.
+func objectKeys(data []byte) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected token %v", tok)
+		}
+		keys = append(keys, key)
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+	}
+	return keys, nil
+}
+
+// PropertyNames returns the names of the schema's properties, in the order
+// they appeared in the document. Properties of schemas that weren't parsed
+// from a document are sorted by name.
+func (s *Schema) PropertyNames() []string {
+	if len(s.order) == len(s.Properties) {
+		return s.order
+	}
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsRequired returns whether the given property is required.
+func (s *Schema) IsRequired(name string) bool {
+	return slices.Contains(s.Required, name)
+}
+
+// Validate validates an object against the schema, returning all the
+// validation errors joined together.
+func (s *Schema) Validate(value map[string]any) error {
+	if err := s.compile(); err != nil {
+		return err
+	}
+	var errs []error
+	for _, name := range s.PropertyNames() {
+		v, ok := value[name]
+		if !ok {
+			if s.IsRequired(name) {
+				errs = append(errs, fmt.Errorf("%s: is required", name))
+			}
+			continue
+		}
+		if err := s.Properties[name].check(v); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// compile compiles the patterns of the schema and its subschemas, so that an
+// invalid one is reported before any value is checked.
+func (s *Schema) compile() error {
+	if s.Pattern != "" && s.pattern == nil {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", s.Pattern, err)
+		}
+		s.pattern = re
+	}
+	for _, name := range s.PropertyNames() {
+		if err := s.Properties[name].compile(); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	if s.Items != nil {
+		if err := s.Items.compile(); err != nil {
+			return fmt.Errorf("items: %w", err)
+		}
+	}
+	return nil
+}
+
+// check validates a single value against the schema.
+func (s *Schema) check(v any) error {
+	switch s.Type {
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("must be a string, got %T", v)
+		}
+		if err := s.checkString(str); err != nil {
+			return err
+		}
+	case "integer", "number":
+		n, ok := toFloat(v)
+		if !ok {
+			return fmt.Errorf("must be a number, got %T", v)
+		}
+		if s.Type == "integer" && n != float64(int64(n)) {
+			return fmt.Errorf("must be an integer, got %v", v)
+		}
+		if err := s.checkNumber(n); err != nil {
+			return err
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("must be a boolean, got %T", v)
+		}
+	case "array":
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice {
+			return fmt.Errorf("must be an array, got %T", v)
+		}
+		if s.Items == nil {
+			return nil
+		}
+		for i := range rv.Len() {
+			if err := s.Items.check(rv.Index(i).Interface()); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return equal(e, v) }) {
+		return fmt.Errorf("must be one of %v", s.Enum)
+	}
+	return nil
+}
+
+func (s *Schema) checkString(str string) error {
+	if s.MinLength != nil && utf8.RuneCountInString(str) < *s.MinLength {
+		return fmt.Errorf("must be at least %d characters long", *s.MinLength)
+	}
+	if s.MaxLength != nil && utf8.RuneCountInString(str) > *s.MaxLength {
+		return fmt.Errorf("must be at most %d characters long", *s.MaxLength)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(str) {
+		return fmt.Errorf("must match the pattern %s", s.Pattern)
+	}
+	return nil
+}
+
+func (s *Schema) checkNumber(n float64) error {
+	if s.Minimum != nil && n < *s.Minimum {
+		return fmt.Errorf("must be at least %v", *s.Minimum)
+	}
+	if s.Maximum != nil && n > *s.Maximum {
+		return fmt.Errorf("must be at most %v", *s.Maximum)
+	}
+	return nil
+}
+
+// equal reports whether two JSON values are equal, comparing numbers by value
+// regardless of their Go type.
+func equal(a, b any) bool {
+	fa, aok := toFloat(a)
+	fb, bok := toFloat(b)
+	if aok && bok {
+		return fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
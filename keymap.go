@@ -6,13 +6,25 @@ import "charm.land/bubbles/v2/key"
 type KeyMap struct {
 	Quit key.Binding
 
-	Confirm     ConfirmKeyMap
-	FilePicker  FilePickerKeyMap
-	Input       InputKeyMap
-	MultiSelect MultiSelectKeyMap
-	Note        NoteKeyMap
-	Select      SelectKeyMap
-	Text        TextKeyMap
+	Confirm         ConfirmKeyMap
+	DatePicker      DatePickerKeyMap
+	DateRangePicker DateRangePickerKeyMap
+	FilePicker      FilePickerKeyMap
+	Input           InputKeyMap
+	KeyValue        KeyValueKeyMap
+	List            ListKeyMap
+	MultiSelect     MultiSelectKeyMap
+	Note            NoteKeyMap
+	Number          NumberKeyMap
+	Password        PasswordKeyMap
+	Rank            RankKeyMap
+	Select          SelectKeyMap
+	Slider          SliderKeyMap
+	TableSelect     TableSelectKeyMap
+	Tags            TagsKeyMap
+	TreeSelect      TreeSelectKeyMap
+	Text            TextKeyMap
+	Review          ReviewKeyMap
 }
 
 // InputKeyMap is the keybindings for input fields.
@@ -23,6 +35,71 @@ type InputKeyMap struct {
 	Submit           key.Binding
 }
 
+// KeyValueKeyMap is the keybindings for key/value fields.
+//
+// Prev only applies when the first cell is being edited, as PrevCell takes
+// precedence.
+type KeyValueKeyMap struct {
+	Next      key.Binding
+	Prev      key.Binding
+	Submit    key.Binding
+	NextCell  key.Binding
+	PrevCell  key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	AddRow    key.Binding
+	DeleteRow key.Binding
+}
+
+// ListKeyMap is the keybindings for list fields.
+type ListKeyMap struct {
+	Next     key.Binding
+	Prev     key.Binding
+	Submit   key.Binding
+	Add      key.Binding
+	Up       key.Binding
+	Down     key.Binding
+	Edit     key.Binding
+	Delete   key.Binding
+	MoveUp   key.Binding
+	MoveDown key.Binding
+	Cancel   key.Binding
+}
+
+// PasswordKeyMap is the keybindings for password fields.
+type PasswordKeyMap struct {
+	Next   key.Binding
+	Prev   key.Binding
+	Submit key.Binding
+	Reveal key.Binding
+}
+
+// RankKeyMap is the keybindings for rank fields.
+type RankKeyMap struct {
+	Next     key.Binding
+	Prev     key.Binding
+	Submit   key.Binding
+	Up       key.Binding
+	Down     key.Binding
+	Grab     key.Binding
+	Drop     key.Binding
+	MoveUp   key.Binding
+	MoveDown key.Binding
+}
+
+// TagsKeyMap is the keybindings for tags fields.
+//
+// Add and Remove only apply when there's a tag being typed, and when there
+// isn't, respectively.
+type TagsKeyMap struct {
+	AcceptSuggestion key.Binding
+	Next             key.Binding
+	Prev             key.Binding
+	Submit           key.Binding
+	Add              key.Binding
+	Remove           key.Binding
+}
+
 // TextKeyMap is the keybindings for text fields.
 type TextKeyMap struct {
 	Next    key.Binding
@@ -50,6 +127,42 @@ type SelectKeyMap struct {
 	Submit       key.Binding
 }
 
+// TableSelectKeyMap is the keybindings for table select fields.
+type TableSelectKeyMap struct {
+	Next         key.Binding
+	Prev         key.Binding
+	Submit       key.Binding
+	Up           key.Binding
+	Down         key.Binding
+	HalfPageUp   key.Binding
+	HalfPageDown key.Binding
+	GotoTop      key.Binding
+	GotoBottom   key.Binding
+	Toggle       key.Binding
+	Sort         key.Binding
+	ReverseSort  key.Binding
+	Filter       key.Binding
+	SetFilter    key.Binding
+	ClearFilter  key.Binding
+}
+
+// TreeSelectKeyMap is the keybindings for tree select fields.
+type TreeSelectKeyMap struct {
+	Next        key.Binding
+	Prev        key.Binding
+	Submit      key.Binding
+	Up          key.Binding
+	Down        key.Binding
+	GotoTop     key.Binding
+	GotoBottom  key.Binding
+	Expand      key.Binding
+	Collapse    key.Binding
+	Toggle      key.Binding
+	Filter      key.Binding
+	SetFilter   key.Binding
+	ClearFilter key.Binding
+}
+
 // MultiSelectKeyMap is the keybindings for multi-select fields.
 type MultiSelectKeyMap struct {
 	Next         key.Binding
@@ -69,6 +182,34 @@ type MultiSelectKeyMap struct {
 	SelectNone   key.Binding
 }
 
+// DatePickerKeyMap is the keybindings for date picker fields.
+type DatePickerKeyMap struct {
+	Next      key.Binding
+	Prev      key.Binding
+	Submit    key.Binding
+	Left      key.Binding
+	Right     key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	PrevMonth key.Binding
+	NextMonth key.Binding
+}
+
+// DateRangePickerKeyMap is the keybindings for date range picker fields.
+type DateRangePickerKeyMap struct {
+	Next      key.Binding
+	Prev      key.Binding
+	Submit    key.Binding
+	Pick      key.Binding
+	Preset    key.Binding
+	Left      key.Binding
+	Right     key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	PrevMonth key.Binding
+	NextMonth key.Binding
+}
+
 // FilePickerKeyMap is the keybindings for filepicker fields.
 type FilePickerKeyMap struct {
 	Open       key.Binding
@@ -93,6 +234,35 @@ type NoteKeyMap struct {
 	Submit key.Binding
 }
 
+// NumberKeyMap is the keybindings for number fields.
+type NumberKeyMap struct {
+	Next      key.Binding
+	Prev      key.Binding
+	Submit    key.Binding
+	Increment key.Binding
+	Decrement key.Binding
+}
+
+// SliderKeyMap is the keybindings for slider fields.
+type SliderKeyMap struct {
+	Next         key.Binding
+	Prev         key.Binding
+	Submit       key.Binding
+	Decrement    key.Binding
+	Increment    key.Binding
+	BigDecrement key.Binding
+	BigIncrement key.Binding
+}
+
+// ReviewKeyMap is the keybindings for the review page.
+type ReviewKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Edit   key.Binding
+	Submit key.Binding
+	Cancel key.Binding
+}
+
 // ConfirmKeyMap is the keybindings for confirm fields.
 type ConfirmKeyMap struct {
 	Next   key.Binding
@@ -113,6 +283,71 @@ func NewDefaultKeyMap() *KeyMap {
 			Next:             key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
 			Submit:           key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
 		},
+		DatePicker: DatePickerKeyMap{
+			Prev:      key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:      key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Submit:    key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Left:      key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "prev day")),
+			Right:     key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "next day")),
+			Up:        key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑", "prev week")),
+			Down:      key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓", "next week")),
+			PrevMonth: key.NewBinding(key.WithKeys("pgup", "["), key.WithHelp("pgup", "prev month")),
+			NextMonth: key.NewBinding(key.WithKeys("pgdown", "]"), key.WithHelp("pgdown", "next month")),
+		},
+		DateRangePicker: DateRangePickerKeyMap{
+			Prev:      key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:      key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Submit:    key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Pick:      key.NewBinding(key.WithKeys("space", "x"), key.WithHelp("space", "pick")),
+			Preset:    key.NewBinding(key.WithKeys("1", "2", "3", "4", "5", "6", "7", "8", "9"), key.WithHelp("1-9", "preset")),
+			Left:      key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "prev day")),
+			Right:     key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "next day")),
+			Up:        key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑", "prev week")),
+			Down:      key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓", "next week")),
+			PrevMonth: key.NewBinding(key.WithKeys("pgup", "["), key.WithHelp("pgup", "prev month")),
+			NextMonth: key.NewBinding(key.WithKeys("pgdown", "]"), key.WithHelp("pgdown", "next month")),
+		},
+		KeyValue: KeyValueKeyMap{
+			Prev:      key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:      key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "next")),
+			Submit:    key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			NextCell:  key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next cell")),
+			PrevCell:  key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "prev cell")),
+			Up:        key.NewBinding(key.WithKeys("up"), key.WithHelp("↑", "up")),
+			Down:      key.NewBinding(key.WithKeys("down"), key.WithHelp("↓", "down")),
+			AddRow:    key.NewBinding(key.WithKeys("ctrl+n"), key.WithHelp("ctrl+n", "add row")),
+			DeleteRow: key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "delete row")),
+		},
+		List: ListKeyMap{
+			Prev:     key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:     key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Submit:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Add:      key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "add")),
+			Up:       key.NewBinding(key.WithKeys("up", "ctrl+p"), key.WithHelp("↑", "up")),
+			Down:     key.NewBinding(key.WithKeys("down", "ctrl+n"), key.WithHelp("↓", "down")),
+			Edit:     key.NewBinding(key.WithKeys("enter", "e"), key.WithHelp("e", "edit")),
+			Delete:   key.NewBinding(key.WithKeys("d", "delete", "backspace"), key.WithHelp("d", "delete")),
+			MoveUp:   key.NewBinding(key.WithKeys("shift+up", "K"), key.WithHelp("K", "move up")),
+			MoveDown: key.NewBinding(key.WithKeys("shift+down", "J"), key.WithHelp("J", "move down")),
+			Cancel:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		},
+		Password: PasswordKeyMap{
+			Prev:   key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:   key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Submit: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Reveal: key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "reveal")),
+		},
+		Rank: RankKeyMap{
+			Prev:     key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:     key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Submit:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Up:       key.NewBinding(key.WithKeys("up", "k", "ctrl+p"), key.WithHelp("↑", "up")),
+			Down:     key.NewBinding(key.WithKeys("down", "j", "ctrl+n"), key.WithHelp("↓", "down")),
+			Grab:     key.NewBinding(key.WithKeys("space", "x"), key.WithHelp("space", "grab")),
+			Drop:     key.NewBinding(key.WithKeys("space", "x", "esc"), key.WithHelp("space", "drop"), key.WithDisabled()),
+			MoveUp:   key.NewBinding(key.WithKeys("shift+up", "K"), key.WithHelp("K", "move up")),
+			MoveDown: key.NewBinding(key.WithKeys("shift+down", "J"), key.WithHelp("J", "move down")),
+		},
 		FilePicker: FilePickerKeyMap{
 			GotoTop:    key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "first"), key.WithDisabled()),
 			GotoBottom: key.NewBinding(key.WithKeys("G"), key.WithHelp("G", "last"), key.WithDisabled()),
@@ -169,6 +404,46 @@ func NewDefaultKeyMap() *KeyMap {
 			SelectAll:    key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "select all")),
 			SelectNone:   key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "select none"), key.WithDisabled()),
 		},
+		Tags: TagsKeyMap{
+			AcceptSuggestion: key.NewBinding(key.WithKeys("ctrl+e"), key.WithHelp("ctrl+e", "complete")),
+			Prev:             key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:             key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Submit:           key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Add:              key.NewBinding(key.WithKeys("enter", ","), key.WithHelp("enter", "add")),
+			Remove:           key.NewBinding(key.WithKeys("backspace"), key.WithHelp("backspace", "remove")),
+		},
+		TableSelect: TableSelectKeyMap{
+			Prev:         key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:         key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "select")),
+			Submit:       key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Up:           key.NewBinding(key.WithKeys("up", "k", "ctrl+p"), key.WithHelp("↑", "up")),
+			Down:         key.NewBinding(key.WithKeys("down", "j", "ctrl+n"), key.WithHelp("↓", "down")),
+			HalfPageUp:   key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("ctrl+u", "½ page up")),
+			HalfPageDown: key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "½ page down")),
+			GotoTop:      key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("g/home", "go to start")),
+			GotoBottom:   key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("G/end", "go to end")),
+			Toggle:       key.NewBinding(key.WithKeys("space", "x"), key.WithHelp("x", "toggle")),
+			Sort:         key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort")),
+			ReverseSort:  key.NewBinding(key.WithKeys("S"), key.WithHelp("S", "reverse"), key.WithDisabled()),
+			Filter:       key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+			SetFilter:    key.NewBinding(key.WithKeys("enter", "esc"), key.WithHelp("esc", "set filter"), key.WithDisabled()),
+			ClearFilter:  key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear filter"), key.WithDisabled()),
+		},
+		TreeSelect: TreeSelectKeyMap{
+			Prev:        key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:        key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "select")),
+			Submit:      key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Up:          key.NewBinding(key.WithKeys("up", "k", "ctrl+p"), key.WithHelp("↑", "up")),
+			Down:        key.NewBinding(key.WithKeys("down", "j", "ctrl+n"), key.WithHelp("↓", "down")),
+			GotoTop:     key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("g/home", "go to start")),
+			GotoBottom:  key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("G/end", "go to end")),
+			Expand:      key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "expand")),
+			Collapse:    key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "collapse")),
+			Toggle:      key.NewBinding(key.WithKeys("space", "x"), key.WithHelp("x", "toggle")),
+			Filter:      key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+			SetFilter:   key.NewBinding(key.WithKeys("enter", "esc"), key.WithHelp("esc", "set filter"), key.WithDisabled()),
+			ClearFilter: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear filter"), key.WithDisabled()),
+		},
 		Note: NoteKeyMap{
 			Prev:   key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
 			Next:   key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
@@ -182,5 +457,28 @@ func NewDefaultKeyMap() *KeyMap {
 			Accept: key.NewBinding(key.WithKeys("y", "Y"), key.WithHelp("y", "Yes")),
 			Reject: key.NewBinding(key.WithKeys("n", "N"), key.WithHelp("n", "No")),
 		},
+		Number: NumberKeyMap{
+			Prev:      key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:      key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Submit:    key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Increment: key.NewBinding(key.WithKeys("up"), key.WithHelp("↑", "increment")),
+			Decrement: key.NewBinding(key.WithKeys("down"), key.WithHelp("↓", "decrement")),
+		},
+		Slider: SliderKeyMap{
+			Prev:         key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:         key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Submit:       key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+			Decrement:    key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "decrease")),
+			Increment:    key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "increase")),
+			BigDecrement: key.NewBinding(key.WithKeys("shift+left", "H"), key.WithHelp("shift+←", "decrease more")),
+			BigIncrement: key.NewBinding(key.WithKeys("shift+right", "L"), key.WithHelp("shift+→", "increase more")),
+		},
+		Review: ReviewKeyMap{
+			Up:     key.NewBinding(key.WithKeys("up", "k", "shift+tab"), key.WithHelp("↑", "up")),
+			Down:   key.NewBinding(key.WithKeys("down", "j", "tab"), key.WithHelp("↓", "down")),
+			Edit:   key.NewBinding(key.WithKeys("enter", "e"), key.WithHelp("enter", "edit")),
+			Submit: key.NewBinding(key.WithKeys("s", "ctrl+s"), key.WithHelp("s", "submit")),
+			Cancel: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		},
 	}
 }
@@ -0,0 +1,135 @@
+package huh
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maskBlank is shown in the slots of a mask that aren't filled yet.
+const maskBlank = '_'
+
+// maskRune is a rune of a mask pattern: a literal, or a slot accepting some
+// runes.
+type maskRune struct {
+	literal rune
+	accepts func(rune) bool
+}
+
+// inputMask is a parsed mask pattern, such as "+1 (999) 999-9999".
+//
+// In a pattern, 9 is a digit, A is a letter, * is a letter or a digit, and any
+// other rune is a literal. A backslash makes the next rune a literal.
+type inputMask struct {
+	runes []maskRune
+	slots []int // index of the slots in runes
+}
+
+// parseMask parses a mask pattern.
+func parseMask(pattern string) *inputMask {
+	m := &inputMask{}
+	escaped := false
+	for _, r := range pattern {
+		mr := maskRune{literal: r}
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+			continue
+		case r == '9':
+			mr.accepts = unicode.IsDigit
+		case r == 'A':
+			mr.accepts = unicode.IsLetter
+		case r == '*':
+			mr.accepts = func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+		}
+		if mr.accepts != nil {
+			m.slots = append(m.slots, len(m.runes))
+		}
+		m.runes = append(m.runes, mr)
+	}
+	return m
+}
+
+// accepts returns whether the given slot accepts a rune.
+func (m *inputMask) accepts(slot int, r rune) bool {
+	return slot < len(m.slots) && m.runes[m.slots[slot]].accepts(r)
+}
+
+// position returns the index of the rune of a slot, or the length of the mask
+// past the last slot.
+func (m *inputMask) position(slot int) int {
+	if slot < len(m.slots) {
+		return m.slots[slot]
+	}
+	return len(m.runes)
+}
+
+// fill returns the mask with the given runes in its slots, and blanks in the
+// others.
+func (m *inputMask) fill(raw []rune) string {
+	var sb strings.Builder
+	slot := 0
+	for _, mr := range m.runes {
+		switch {
+		case mr.accepts == nil:
+			sb.WriteRune(mr.literal)
+		case slot < len(raw):
+			sb.WriteRune(raw[slot])
+			slot++
+		default:
+			sb.WriteRune(maskBlank)
+		}
+	}
+	return sb.String()
+}
+
+// format returns the mask filled with the given runes, up to the last of
+// them.
+func (m *inputMask) format(raw []rune) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	return string([]rune(m.fill(raw))[:m.position(len(raw)-1)+1])
+}
+
+// complete returns whether the given runes fill every slot.
+func (m *inputMask) complete(raw []rune) bool {
+	return len(raw) == len(m.slots)
+}
+
+// valid returns the longest prefix of runes accepted by the slots.
+func (m *inputMask) valid(raw []rune) []rune {
+	for i, r := range raw {
+		if !m.accepts(i, r) {
+			return raw[:i]
+		}
+	}
+	return raw
+}
+
+// extract returns the runes filling the slots from a raw or formatted value.
+// Literals and runes not accepted by their slot are skipped.
+func (m *inputMask) extract(s string) []rune {
+	var raw []rune
+	pos := 0
+	for _, r := range s {
+		if len(raw) == len(m.slots) {
+			break
+		}
+		if pos < len(m.runes) && m.runes[pos].accepts == nil && m.runes[pos].literal == r {
+			pos++
+			continue
+		}
+		if m.accepts(len(raw), r) {
+			raw = append(raw, r)
+			pos = m.position(len(raw))
+		}
+	}
+	return raw
+}
+
+// String returns the mask with blanks in its slots.
+func (m *inputMask) String() string {
+	return m.fill(nil)
+}
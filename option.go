@@ -1,12 +1,19 @@
 package huh
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Option is an option for select fields.
 type Option[T comparable] struct {
-	Key      string
-	Value    T
-	selected bool
+	Key         string
+	Value       T
+	selected    bool
+	other       bool // the free text option of Select.Other
+	description string
+	disabled    bool
+	reason      string // why the option is disabled
 }
 
 // NewOptions returns new options from a list of values.
@@ -32,6 +39,95 @@ func (o Option[T]) Selected(selected bool) Option[T] {
 	return o
 }
 
+// Description sets a hint shown beside the key of the option.
+func (o Option[T]) Description(description string) Option[T] {
+	o.description = description
+	return o
+}
+
+// Disabled disables the option, for the given reason. Disabled options are
+// dimmed, skipped by the cursor, and can't be selected.
+func (o Option[T]) Disabled(reason string) Option[T] {
+	o.disabled = true
+	o.reason = reason
+	return o
+}
+
+// disabledError returns the error of selecting a disabled option.
+func (o Option[T]) disabledError() error {
+	if o.reason == "" {
+		return fmt.Errorf("%s is unavailable", o.Key)
+	}
+	return fmt.Errorf("%s is unavailable: %s", o.Key, o.reason)
+}
+
+// accessibleString returns the key of the option for accessible listings,
+// with its description and whether it's disabled.
+func (o Option[T]) accessibleString() string {
+	var sb strings.Builder
+	sb.WriteString(o.Key)
+	if o.description != "" {
+		sb.WriteString(" - " + o.description)
+	}
+	switch {
+	case o.disabled && o.reason != "":
+		sb.WriteString(" (unavailable: " + o.reason + ")")
+	case o.disabled:
+		sb.WriteString(" (unavailable)")
+	}
+	return sb.String()
+}
+
+// optionSuffix renders the description of an option and the reason it's
+// disabled, shown beside its key.
+func optionSuffix[T comparable](option Option[T], styles *FieldStyles) string {
+	var parts []string
+	if option.description != "" {
+		parts = append(parts, styles.OptionDescription.Render(option.description))
+	}
+	if option.disabled && option.reason != "" {
+		parts = append(parts, styles.DisabledOption.Render("("+option.reason+")"))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " " + strings.Join(parts, " ")
+}
+
+// nextEnabled returns the index of the first enabled option from an index,
+// moving by step and wrapping around if wrap is set, or -1 if there's none.
+func nextEnabled[T comparable](options []Option[T], from, step int, wrap bool) int {
+	n := len(options)
+	for i, idx := 0, from; i < n; i, idx = i+1, idx+step {
+		if idx < 0 || idx >= n {
+			if !wrap {
+				return -1
+			}
+			idx = (idx%n + n) % n
+		}
+		if !options[idx].disabled {
+			return idx
+		}
+	}
+	return -1
+}
+
+// skipDisabled returns the index of the enabled option nearest to an index,
+// looking in the direction of step first. Without wrapping, it looks back the
+// other way. It returns the index itself if every option is disabled.
+func skipDisabled[T comparable](options []Option[T], i, step int, wrap bool) int {
+	if i < 0 || i >= len(options) {
+		return i
+	}
+	if j := nextEnabled(options, i, step, wrap); j >= 0 {
+		return j
+	}
+	if j := nextEnabled(options, i, -step, false); j >= 0 {
+		return j
+	}
+	return i
+}
+
 // String returns the key of the option.
 func (o Option[T]) String() string {
 	return o.Key
@@ -0,0 +1,113 @@
+package huh
+
+import (
+	"slices"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+)
+
+// otherInput is the free text entry of the "Other…" option of select fields,
+// for answers that aren't among the options.
+type otherInput struct {
+	label    string
+	input    textinput.Model
+	validate func(string) error
+	editing  bool
+}
+
+// newOtherInput returns the entry of an "Other…" option, labeled as given and
+// validated by the given function, or ValidateNotEmpty if it's nil.
+func newOtherInput(label string, validate func(string) error) *otherInput {
+	input := textinput.New()
+	input.Prompt = ""
+	if validate == nil {
+		validate = ValidateNotEmpty()
+	}
+	return &otherInput{
+		label:    label,
+		input:    input,
+		validate: validate,
+	}
+}
+
+// acceptsOther returns whether a select field of T can have an "Other…"
+// option, which is only the case for strings.
+func acceptsOther[T comparable]() bool {
+	_, ok := any(*new(T)).(string)
+	return ok
+}
+
+// withOther returns the options with the "Other…" option appended, if there's
+// one, replacing the one they may already have. The options aren't modified.
+func withOther[T comparable](options []Option[T], other *otherInput, selected bool) []Option[T] {
+	options = slices.DeleteFunc(slices.Clone(options), func(o Option[T]) bool { return o.other })
+	if other == nil {
+		return options
+	}
+	return append(options, Option[T]{Key: other.label, other: true, selected: selected})
+}
+
+// withoutOther returns the options without the "Other…" option.
+func withoutOther[T comparable](options []Option[T]) []Option[T] {
+	return slices.DeleteFunc(slices.Clone(options), func(o Option[T]) bool { return o.other })
+}
+
+// isOption returns whether a value is the one of an option, other than the
+// "Other…" one.
+func isOption[T comparable](options []Option[T], value T) bool {
+	return slices.ContainsFunc(options, func(o Option[T]) bool { return !o.other && o.Value == value })
+}
+
+// otherValue returns the text of the "Other…" option as a T.
+func otherValue[T comparable](other *otherInput) T {
+	value, _ := any(other.input.Value()).(T)
+	return value
+}
+
+// setValue sets the text of the entry from a value, returning whether it's a
+// non-empty string.
+func (o *otherInput) setValue(value any) bool {
+	text, ok := value.(string)
+	if !ok || text == "" {
+		return false
+	}
+	o.input.SetValue(text)
+	return true
+}
+
+// edit starts editing the text of the entry.
+func (o *otherInput) edit() tea.Cmd {
+	o.editing = true
+	o.input.CursorEnd()
+	return o.input.Focus()
+}
+
+// stopEditing stops editing the text of the entry.
+func (o *otherInput) stopEditing() {
+	o.editing = false
+	o.input.Blur()
+}
+
+// leaves returns whether a key leaves the entry being edited, moving the
+// cursor of the field: the keys moving it that don't type text.
+func (o *otherInput) leaves(msg tea.KeyPressMsg, bindings ...key.Binding) bool {
+	return msg.Text == "" && key.Matches(msg, bindings...)
+}
+
+// view renders the label of the option followed by the entry being edited,
+// or its text.
+func (o *otherInput) view(styles *FieldStyles) string {
+	if o.editing {
+		st := o.input.Styles()
+		st.Cursor.Color = styles.TextInput.Cursor.GetForeground()
+		st.Focused.Text = styles.TextInput.Text
+		o.input.SetStyles(st)
+		return o.label + " " + o.input.View()
+	}
+	if text := o.input.Value(); text != "" {
+		return o.label + " " + text
+	}
+	return o.label
+}
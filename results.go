@@ -0,0 +1,241 @@
+package huh
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GetAs returns the result of the field with the given key as a T.
+//
+// An error is returned if there's no result for the key, or if it isn't a T.
+func GetAs[T any](f *Form, key string) (T, error) {
+	var zero T
+	v, ok := f.results[key]
+	if !ok {
+		return zero, fmt.Errorf("huh: no result for %q", key)
+	}
+	t, ok := v.(T)
+	if !ok {
+		return zero, fmt.Errorf("huh: result for %q is %T, not %T", key, v, zero)
+	}
+	return t, nil
+}
+
+// Results returns the values of the form's fields, by key.
+//
+// Only the fields of the groups the form goes through are included, and
+// hidden fields and fields without a key are left out.
+func (f *Form) Results() map[string]any {
+	results := make(map[string]any)
+	for _, g := range f.path() {
+		f.selector.Get(g).selector.Range(func(_ int, field Field) bool {
+			if field.GetKey() == "" || isFieldHidden(field) {
+				return true
+			}
+			results[field.GetKey()] = field.GetValue()
+			return true
+		})
+	}
+	return results
+}
+
+// EncodeJSON writes the results as an indented JSON object.
+func EncodeJSON(w io.Writer, results map[string]any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results) //nolint:wrapcheck
+}
+
+// EncodeYAML writes the results as a YAML mapping.
+func EncodeYAML(w io.Writer, results map[string]any) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2) //nolint:mnd
+	if err := enc.Encode(results); err != nil {
+		return err //nolint:wrapcheck
+	}
+	return enc.Close() //nolint:wrapcheck
+}
+
+// EncodeDotenv writes the results as KEY="value" lines, sorted by key.
+//
+// Keys are named by [EnvName], without a prefix. Values are double quoted,
+// with backslashes, quotes, dollar signs and line breaks escaped. Lists are
+// joined with commas.
+func EncodeDotenv(w io.Writer, results map[string]any) error {
+	keys := make([]string, 0, len(results))
+	for k := range results {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if _, err := fmt.Fprintf(w, "%s=%s\n", EnvName("", k), dotenvQuote(dotenvValue(results[k]))); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+	return nil
+}
+
+// dotenvEscaper escapes the characters dotenv files treat specially within
+// double quotes.
+var dotenvEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	`$`, `\$`,
+	"\n", `\n`,
+	"\r", `\r`,
+)
+
+// dotenvQuote double quotes a value for dotenv files.
+func dotenvQuote(s string) string {
+	return `"` + dotenvEscaper.Replace(s) + `"`
+}
+
+// dotenvValue formats a value, joining lists with commas.
+func dotenvValue(value any) string {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 {
+		return answerString(value)
+	}
+	items := make([]string, v.Len())
+	for i := range items {
+		items[i] = answerString(v.Index(i).Interface())
+	}
+	return strings.Join(items, ",")
+}
+
+// DecodeResults decodes results into the struct pointed to by v.
+//
+// Struct fields are matched by key, which is set with the `huh:"key=..."`
+// struct tag and defaults to the field's name, as with [FormFromStruct].
+// Nested structs are decoded from the same results. Results without a
+// matching field are ignored.
+func DecodeResults(results map[string]any, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("huh: DecodeResults expects a non-nil pointer to a struct, got %T", v)
+	}
+	return decodeStruct(results, rv.Elem())
+}
+
+func decodeStruct(results map[string]any, v reflect.Value) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag, err := parseStructTag(sf.Tag.Get(structTagName))
+		if err != nil {
+			return fmt.Errorf("huh: field %s: %w", sf.Name, err)
+		}
+		if tag.skip {
+			continue
+		}
+
+		fv := v.Field(i)
+		key := cmp.Or(tag.key, sf.Name)
+		value, ok := results[key]
+
+		// structs such as time.Time are values, other structs are nested.
+		if fv.Kind() == reflect.Struct && (!ok || value == nil || !reflect.TypeOf(value).AssignableTo(fv.Type())) {
+			if err := decodeStruct(results, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if err := decodeValue(fv, value); err != nil {
+			return fmt.Errorf("huh: field %s: %w", sf.Name, err)
+		}
+	}
+	return nil
+}
+
+// decodeValue sets dst to value, converting it if needed.
+func decodeValue(dst reflect.Value, value any) error {
+	if value == nil {
+		dst.SetZero()
+		return nil
+	}
+	src := reflect.ValueOf(value)
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+	case src.Kind() == reflect.Slice && dst.Kind() == reflect.Slice:
+		slice := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+		for i := range src.Len() {
+			if err := decodeValue(slice.Index(i), src.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		dst.Set(slice)
+	case isNumber(src.Kind()) && isNumber(dst.Kind()):
+		if !numberFits(src, dst.Type()) {
+			return fmt.Errorf("can't decode %v into %s", value, dst.Type())
+		}
+		dst.Set(src.Convert(dst.Type()))
+	case src.Kind() == reflect.String && dst.Kind() == reflect.String:
+		dst.Set(src.Convert(dst.Type()))
+	default:
+		return fmt.Errorf("can't decode %T into %s", value, dst.Type())
+	}
+	return nil
+}
+
+// numberFits reports whether the number v converts to the number type t as
+// is, without truncating a fraction, overflowing or losing its sign.
+func numberFits(v reflect.Value, t reflect.Type) bool {
+	dst := reflect.Zero(t)
+	switch {
+	case v.CanInt():
+		n := v.Int()
+		switch {
+		case dst.CanInt():
+			return !dst.OverflowInt(n)
+		case dst.CanUint():
+			return n >= 0 && !dst.OverflowUint(uint64(n))
+		}
+	case v.CanUint():
+		n := v.Uint()
+		switch {
+		case dst.CanInt():
+			return n <= math.MaxInt64 && !dst.OverflowInt(int64(n))
+		case dst.CanUint():
+			return !dst.OverflowUint(n)
+		}
+	case v.CanFloat():
+		f := v.Float()
+		switch {
+		case dst.CanInt():
+			return f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 && !dst.OverflowInt(int64(f))
+		case dst.CanUint():
+			return f == math.Trunc(f) && f >= 0 && f < math.MaxUint64 && !dst.OverflowUint(uint64(f))
+		case dst.CanFloat():
+			return !dst.OverflowFloat(f)
+		}
+	}
+	// integers always fit floats, if not exactly.
+	return true
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
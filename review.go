@@ -0,0 +1,195 @@
+package huh
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2/internal/accessibility"
+	"charm.land/lipgloss/v2"
+)
+
+const reviewTitle = "Review your answers"
+
+// reviewer is implemented by fields that know how to show themselves on the
+// review page. Other fields are shown by key and raw value.
+type reviewer interface {
+	review() (title, value string)
+}
+
+// reviewItem is a field listed on the review page.
+type reviewItem struct {
+	group int
+	field int
+	title string
+	value string
+}
+
+// reviewItems returns the fields listed on the review page.
+func (f *Form) reviewItems() []reviewItem {
+	var items []reviewItem
+	for _, g := range f.path() {
+		f.selector.Get(g).selector.Range(func(i int, field Field) bool {
+			if field.Skip() {
+				return true
+			}
+			item := reviewItem{group: g, field: i}
+			if r, ok := field.(reviewer); ok {
+				item.title, item.value = r.review()
+			} else {
+				item.value = fmt.Sprint(field.GetValue())
+			}
+			if item.title == "" {
+				item.title = field.GetKey()
+			}
+			items = append(items, item)
+			return true
+		})
+	}
+	return items
+}
+
+// startReview shows the review page. Coming back from an edit, the cursor
+// stays on the edited field.
+func (f *Form) startReview() {
+	if !f.reviewed {
+		f.reviewCursor = 0
+	}
+	f.reviewing = true
+	f.reviewed = true
+	f.reviewedGroups = make(map[int]bool)
+	for _, i := range f.path() {
+		f.reviewedGroups[i] = true
+	}
+}
+
+// updateReview handles key presses on the review page.
+func (f *Form) updateReview(msg tea.KeyPressMsg) tea.Cmd {
+	items := f.reviewItems()
+	switch {
+	case key.Matches(msg, f.keymap.Review.Up):
+		f.reviewCursor = max(f.reviewCursor-1, 0)
+	case key.Matches(msg, f.keymap.Review.Down):
+		f.reviewCursor = max(min(f.reviewCursor+1, len(items)-1), 0)
+	case key.Matches(msg, f.keymap.Review.Edit):
+		// every field may be skipped, leaving nothing to edit.
+		if len(items) == 0 {
+			return nil
+		}
+		return f.editField(items[f.reviewCursor])
+	case key.Matches(msg, f.keymap.Review.Submit):
+		f.reviewing = false
+		f.dropHiddenResults()
+		f.quitting = true
+		f.State = StateCompleted
+		return f.SubmitCmd
+	case key.Matches(msg, f.keymap.Review.Cancel):
+		f.reviewing = false
+		f.aborted = true
+		f.quitting = true
+		f.State = StateAborted
+		return f.CancelCmd
+	}
+	return nil
+}
+
+// editField leaves the review page and focuses the given field. The history
+// is rewound to the field's group, so going back leads to the group before it.
+func (f *Form) editField(item reviewItem) tea.Cmd {
+	f.reviewing = false
+	if i := slices.Index(f.history, item.group); i >= 0 {
+		f.history = f.history[:i]
+	}
+	f.selector.SetIndex(item.group)
+	group := f.selector.Selected()
+	group.selector.SetIndex(item.field)
+	group.active = true
+	return group.Init()
+}
+
+// reviewKeyBinds returns the help keybindings of the review page.
+func (f *Form) reviewKeyBinds() []key.Binding {
+	return []key.Binding{
+		f.keymap.Review.Up,
+		f.keymap.Review.Down,
+		f.keymap.Review.Edit,
+		f.keymap.Review.Submit,
+		f.keymap.Review.Cancel,
+	}
+}
+
+// reviewView renders the review page.
+func (f *Form) reviewView() string {
+	styles := f.getTheme().Focused
+	items := f.reviewItems()
+
+	titleWidth := 0
+	for _, item := range items {
+		titleWidth = max(titleWidth, lipgloss.Width(item.title))
+	}
+	selector := styles.SelectSelector.String()
+	indent := strings.Repeat(" ", lipgloss.Width(selector))
+
+	var sb strings.Builder
+	sb.WriteString(styles.Title.Render(reviewTitle))
+	for i, item := range items {
+		sb.WriteString("\n")
+		title := item.title + strings.Repeat(" ", titleWidth-lipgloss.Width(item.title))
+		if i == f.reviewCursor {
+			sb.WriteString(selector)
+			sb.WriteString(styles.SelectedOption.Render(title))
+		} else {
+			sb.WriteString(indent)
+			sb.WriteString(styles.UnselectedOption.Render(title))
+		}
+		sb.WriteString("  ")
+		sb.WriteString(styles.Option.Render(item.value))
+	}
+
+	group := f.selector.Selected()
+	view := styles.Base.Width(group.width).Render(sb.String())
+	if group.showHelp {
+		view += "\n\n" + group.help.ShortHelpView(f.reviewKeyBinds())
+	}
+	return view
+}
+
+// runReviewAccessible prints the review page, letting the user edit answers
+// until they go on, and asks whether to submit. The groups an edit makes
+// reachable are prompted for before the review page is printed again; answered
+// holds the groups already prompted for.
+func (f *Form) runReviewAccessible(w io.Writer, r io.Reader, answered map[int]bool) error {
+	for {
+		items := f.reviewItems()
+		_, _ = fmt.Fprintln(w, reviewTitle)
+		for i, item := range items {
+			_, _ = fmt.Fprintf(w, "%d. %s: %s\n", i+1, item.title, item.value)
+		}
+		if len(items) == 0 {
+			break
+		}
+		none := 0
+		choice := accessibility.PromptInt(w, r, "Edit an answer? Enter its number, or nothing to go on: ", 0, len(items), &none)
+		if choice == 0 {
+			break
+		}
+		_, _ = fmt.Fprintln(w)
+		item := items[choice-1]
+		f.runFieldAccessible(f.selector.Get(item.group), item.field, w, r)
+		for _, g := range f.path() {
+			if !answered[g] {
+				answered[g] = true
+				f.runGroupAccessible(g, w, r)
+			}
+		}
+	}
+	if !accessibility.PromptBool(w, r, "Submit? ", true) {
+		f.State = StateAborted
+		return ErrUserAborted
+	}
+	f.State = StateCompleted
+	return nil
+}
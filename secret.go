@@ -0,0 +1,123 @@
+package huh
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+)
+
+// Zero overwrites a secret with zeros. Use it once a secret value has been
+// consumed, so it doesn't linger in memory.
+func Zero(secret []byte) {
+	clear(secret)
+}
+
+// secretBuffer holds the runes of a secret being typed. Unlike strings, it's
+// wiped whenever its runes are removed or moved to a bigger buffer.
+type secretBuffer struct {
+	runes  []rune
+	cursor int
+}
+
+// load replaces the runes of the buffer with the ones of a secret.
+func (b *secretBuffer) load(secret []byte) {
+	b.wipe()
+	b.grow(utf8.RuneCount(secret))
+	for len(secret) > 0 {
+		r, size := utf8.DecodeRune(secret)
+		b.runes = append(b.runes, r)
+		secret = secret[size:]
+	}
+	b.cursor = len(b.runes)
+}
+
+// bytes returns the secret encoded in a new slice, owned by the caller.
+func (b *secretBuffer) bytes() []byte {
+	n := 0
+	for _, r := range b.runes {
+		n += utf8.RuneLen(r)
+	}
+	secret := make([]byte, 0, n)
+	for _, r := range b.runes {
+		secret = utf8.AppendRune(secret, r)
+	}
+	return secret
+}
+
+// wipe zeroes the runes of the buffer and empties it.
+func (b *secretBuffer) wipe() {
+	clear(b.runes[:cap(b.runes)])
+	b.runes = b.runes[:0]
+	b.cursor = 0
+}
+
+// grow makes room for n more runes, wiping the runes left behind.
+func (b *secretBuffer) grow(n int) {
+	if len(b.runes)+n <= cap(b.runes) {
+		return
+	}
+	runes := make([]rune, len(b.runes), max(2*cap(b.runes), len(b.runes)+n))
+	copy(runes, b.runes)
+	clear(b.runes)
+	b.runes = runes
+}
+
+// echo fills a text input with as many echo characters as the buffer has
+// runes, with the cursor at the buffer's, so the secret never reaches it.
+func (b *secretBuffer) echo(input *textinput.Model) {
+	input.SetValue(strings.Repeat(string(input.EchoCharacter), len(b.runes)))
+	input.SetCursor(b.cursor)
+}
+
+// update edits the buffer with the keys of a text input. limit is the maximum
+// number of runes, if positive.
+func (b *secretBuffer) update(keymap textinput.KeyMap, msg tea.KeyPressMsg, limit int) {
+	switch {
+	case key.Matches(msg, keymap.DeleteCharacterBackward):
+		if b.cursor > 0 {
+			b.cursor--
+			b.runes = slices.Delete(b.runes, b.cursor, b.cursor+1)
+		}
+	case key.Matches(msg, keymap.DeleteCharacterForward):
+		if b.cursor < len(b.runes) {
+			b.runes = slices.Delete(b.runes, b.cursor, b.cursor+1)
+		}
+	case key.Matches(msg, keymap.DeleteBeforeCursor):
+		b.runes = slices.Delete(b.runes, 0, b.cursor)
+		b.cursor = 0
+	case key.Matches(msg, keymap.DeleteAfterCursor):
+		b.runes = slices.Delete(b.runes, b.cursor, len(b.runes))
+	case key.Matches(msg, keymap.CharacterBackward):
+		b.cursor = max(b.cursor-1, 0)
+	case key.Matches(msg, keymap.CharacterForward):
+		b.cursor = min(b.cursor+1, len(b.runes))
+	case key.Matches(msg, keymap.LineStart):
+		b.cursor = 0
+	case key.Matches(msg, keymap.LineEnd):
+		b.cursor = len(b.runes)
+	default:
+		b.insert(msg.Text, limit)
+	}
+}
+
+// insert types text into the buffer at the cursor, such as pasted text. Line
+// breaks and other control characters are dropped. limit is the maximum
+// number of runes, if positive.
+func (b *secretBuffer) insert(text string, limit int) {
+	for _, r := range text {
+		if limit > 0 && len(b.runes) >= limit {
+			break
+		}
+		if unicode.IsControl(r) {
+			continue
+		}
+		b.grow(1)
+		b.runes = slices.Insert(b.runes, b.cursor, r)
+		b.cursor++
+	}
+}
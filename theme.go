@@ -56,6 +56,10 @@ type FieldStyles struct {
 	NextIndicator  lipgloss.Style
 	PrevIndicator  lipgloss.Style
 
+	// Option styles.
+	OptionDescription lipgloss.Style // Hint beside an option
+	DisabledOption    lipgloss.Style // Options that can't be selected
+
 	// FilePicker styles.
 	Directory lipgloss.Style
 	File      lipgloss.Style
@@ -74,6 +78,33 @@ type FieldStyles struct {
 	FocusedButton lipgloss.Style
 	BlurredButton lipgloss.Style
 
+	// DatePicker styles.
+	CalendarHeader   lipgloss.Style // Month and year
+	CalendarWeekday  lipgloss.Style // Weekday names
+	CalendarDay      lipgloss.Style
+	CalendarToday    lipgloss.Style
+	CalendarCursor   lipgloss.Style // Day under the cursor
+	CalendarSelected lipgloss.Style // Picked day
+	CalendarRange    lipgloss.Style // Days within a picked range
+	CalendarDisabled lipgloss.Style // Days out of bounds
+
+	// Slider styles, with the character they repeat set as their string.
+	SliderTrack  lipgloss.Style
+	SliderFilled lipgloss.Style
+	SliderThumb  lipgloss.Style
+
+	// TableSelect styles.
+	TableHeader lipgloss.Style
+
+	// Password strength meter styles, with their segment set as their string.
+	PasswordMeter  lipgloss.Style // Segments above the strength
+	PasswordWeak   lipgloss.Style
+	PasswordFair   lipgloss.Style
+	PasswordStrong lipgloss.Style
+
+	// Tags styles.
+	Tag lipgloss.Style // Chip of a tag
+
 	// Card styles.
 	Card      lipgloss.Style
 	NoteTitle lipgloss.Style
@@ -121,6 +152,24 @@ func ThemeBase(bool) *Styles {
 	t.Focused.FocusedButton = button.Foreground(lipgloss.Color("0")).Background(lipgloss.Color("7"))
 	t.Focused.BlurredButton = button.Foreground(lipgloss.Color("7")).Background(lipgloss.Color("0"))
 	t.Focused.TextInput.Placeholder = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	t.Focused.CalendarHeader = lipgloss.NewStyle().Bold(true)
+	t.Focused.CalendarWeekday = lipgloss.NewStyle().Faint(true)
+	t.Focused.CalendarToday = lipgloss.NewStyle().Underline(true)
+	t.Focused.CalendarCursor = lipgloss.NewStyle().Reverse(true)
+	t.Focused.CalendarSelected = lipgloss.NewStyle().Bold(true)
+	t.Focused.CalendarRange = lipgloss.NewStyle().Underline(true)
+	t.Focused.CalendarDisabled = lipgloss.NewStyle().Faint(true)
+	t.Focused.SliderTrack = lipgloss.NewStyle().Faint(true).SetString("─")
+	t.Focused.SliderFilled = lipgloss.NewStyle().SetString("━")
+	t.Focused.SliderThumb = lipgloss.NewStyle().SetString("●")
+	t.Focused.TableHeader = lipgloss.NewStyle().Bold(true)
+	t.Focused.PasswordMeter = lipgloss.NewStyle().Faint(true).SetString("━━━")
+	t.Focused.PasswordWeak = lipgloss.NewStyle().Foreground(lipgloss.Color("1")).SetString("━━━")
+	t.Focused.PasswordFair = lipgloss.NewStyle().Foreground(lipgloss.Color("3")).SetString("━━━")
+	t.Focused.PasswordStrong = lipgloss.NewStyle().Foreground(lipgloss.Color("2")).SetString("━━━")
+	t.Focused.Tag = lipgloss.NewStyle().Reverse(true).Padding(0, 1)
+	t.Focused.OptionDescription = lipgloss.NewStyle().Faint(true)
+	t.Focused.DisabledOption = lipgloss.NewStyle().Faint(true)
 
 	t.Help = help.New().Styles
 
@@ -174,6 +223,27 @@ func ThemeCharm(isDark bool) *Styles {
 	t.Focused.TextInput.Placeholder = t.Focused.TextInput.Placeholder.Foreground(lightDark(lipgloss.Color("248"), lipgloss.Color("238")))
 	t.Focused.TextInput.Prompt = t.Focused.TextInput.Prompt.Foreground(fuchsia)
 
+	t.Focused.CalendarHeader = t.Focused.CalendarHeader.Foreground(indigo)
+	t.Focused.CalendarWeekday = t.Focused.CalendarWeekday.Foreground(lightDark(lipgloss.Color(""), lipgloss.Color("243")))
+	t.Focused.CalendarDay = t.Focused.CalendarDay.Foreground(normalFg)
+	t.Focused.CalendarToday = t.Focused.CalendarToday.Foreground(green)
+	t.Focused.CalendarCursor = t.Focused.CalendarCursor.UnsetReverse().Foreground(cream).Background(fuchsia)
+	t.Focused.CalendarSelected = t.Focused.CalendarSelected.Foreground(fuchsia)
+	t.Focused.CalendarRange = t.Focused.CalendarRange.UnsetUnderline().Foreground(cream).Background(indigo)
+	t.Focused.CalendarDisabled = t.Focused.CalendarDisabled.Foreground(lightDark(lipgloss.Color("248"), lipgloss.Color("238")))
+
+	t.Focused.SliderTrack = t.Focused.SliderTrack.UnsetFaint().Foreground(lightDark(lipgloss.Color("248"), lipgloss.Color("238")))
+	t.Focused.SliderFilled = t.Focused.SliderFilled.Foreground(indigo)
+	t.Focused.SliderThumb = t.Focused.SliderThumb.Foreground(fuchsia)
+	t.Focused.TableHeader = t.Focused.TableHeader.Foreground(indigo)
+	t.Focused.PasswordMeter = t.Focused.PasswordMeter.UnsetFaint().Foreground(lightDark(lipgloss.Color("248"), lipgloss.Color("238")))
+	t.Focused.PasswordWeak = t.Focused.PasswordWeak.Foreground(red)
+	t.Focused.PasswordFair = t.Focused.PasswordFair.Foreground(fuchsia)
+	t.Focused.PasswordStrong = t.Focused.PasswordStrong.Foreground(green)
+	t.Focused.Tag = t.Focused.Tag.UnsetReverse().Foreground(cream).Background(indigo)
+	t.Focused.OptionDescription = t.Focused.OptionDescription.UnsetFaint().Foreground(lightDark(lipgloss.Color(""), lipgloss.Color("243")))
+	t.Focused.DisabledOption = t.Focused.DisabledOption.UnsetFaint().Foreground(lightDark(lipgloss.Color("248"), lipgloss.Color("238")))
+
 	t.Blurred = t.Focused
 	t.Blurred.Base = t.Focused.Base.BorderStyle(lipgloss.HiddenBorder())
 	t.Blurred.Card = t.Blurred.Base
@@ -225,6 +295,27 @@ func ThemeDracula(isDark bool) *Styles {
 	t.Focused.TextInput.Placeholder = t.Focused.TextInput.Placeholder.Foreground(comment)
 	t.Focused.TextInput.Prompt = t.Focused.TextInput.Prompt.Foreground(yellow)
 
+	t.Focused.CalendarHeader = t.Focused.CalendarHeader.Foreground(purple)
+	t.Focused.CalendarWeekday = t.Focused.CalendarWeekday.Foreground(comment)
+	t.Focused.CalendarDay = t.Focused.CalendarDay.Foreground(foreground)
+	t.Focused.CalendarToday = t.Focused.CalendarToday.Foreground(green)
+	t.Focused.CalendarCursor = t.Focused.CalendarCursor.UnsetReverse().Foreground(background).Background(yellow)
+	t.Focused.CalendarSelected = t.Focused.CalendarSelected.Foreground(yellow)
+	t.Focused.CalendarRange = t.Focused.CalendarRange.UnsetUnderline().Foreground(foreground).Background(selection)
+	t.Focused.CalendarDisabled = t.Focused.CalendarDisabled.Foreground(selection)
+
+	t.Focused.SliderTrack = t.Focused.SliderTrack.UnsetFaint().Foreground(selection)
+	t.Focused.SliderFilled = t.Focused.SliderFilled.Foreground(purple)
+	t.Focused.SliderThumb = t.Focused.SliderThumb.Foreground(yellow)
+	t.Focused.TableHeader = t.Focused.TableHeader.Foreground(purple)
+	t.Focused.PasswordMeter = t.Focused.PasswordMeter.UnsetFaint().Foreground(selection)
+	t.Focused.PasswordWeak = t.Focused.PasswordWeak.Foreground(red)
+	t.Focused.PasswordFair = t.Focused.PasswordFair.Foreground(yellow)
+	t.Focused.PasswordStrong = t.Focused.PasswordStrong.Foreground(green)
+	t.Focused.Tag = t.Focused.Tag.UnsetReverse().Foreground(foreground).Background(selection)
+	t.Focused.OptionDescription = t.Focused.OptionDescription.UnsetFaint().Foreground(comment)
+	t.Focused.DisabledOption = t.Focused.DisabledOption.UnsetFaint().Foreground(selection)
+
 	t.Blurred = t.Focused
 	t.Blurred.Base = t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
 	t.Blurred.Card = t.Blurred.Base
@@ -263,6 +354,24 @@ func ThemeBase16(isDark bool) *Styles {
 	t.Focused.TextInput.Placeholder.Foreground(lipgloss.Color("8"))
 	t.Focused.TextInput.Prompt.Foreground(lipgloss.Color("3"))
 
+	t.Focused.CalendarHeader = t.Focused.CalendarHeader.Foreground(lipgloss.Color("6"))
+	t.Focused.CalendarWeekday = t.Focused.CalendarWeekday.Foreground(lipgloss.Color("8"))
+	t.Focused.CalendarDay = t.Focused.CalendarDay.Foreground(lipgloss.Color("7"))
+	t.Focused.CalendarToday = t.Focused.CalendarToday.Foreground(lipgloss.Color("2"))
+	t.Focused.CalendarCursor = t.Focused.CalendarCursor.UnsetReverse().Foreground(lipgloss.Color("7")).Background(lipgloss.Color("5"))
+	t.Focused.CalendarSelected = t.Focused.CalendarSelected.Foreground(lipgloss.Color("3"))
+	t.Focused.CalendarRange = t.Focused.CalendarRange.UnsetUnderline().Foreground(lipgloss.Color("0")).Background(lipgloss.Color("6"))
+	t.Focused.CalendarDisabled = t.Focused.CalendarDisabled.Foreground(lipgloss.Color("8"))
+
+	t.Focused.SliderTrack = t.Focused.SliderTrack.UnsetFaint().Foreground(lipgloss.Color("8"))
+	t.Focused.SliderFilled = t.Focused.SliderFilled.Foreground(lipgloss.Color("6"))
+	t.Focused.SliderThumb = t.Focused.SliderThumb.Foreground(lipgloss.Color("3"))
+	t.Focused.TableHeader = t.Focused.TableHeader.Foreground(lipgloss.Color("6"))
+	t.Focused.PasswordMeter = t.Focused.PasswordMeter.UnsetFaint().Foreground(lipgloss.Color("8"))
+	t.Focused.PasswordWeak = t.Focused.PasswordWeak.Foreground(lipgloss.Color("9"))
+	t.Focused.Tag = t.Focused.Tag.UnsetReverse().Foreground(lipgloss.Color("0")).Background(lipgloss.Color("6"))
+	t.Focused.DisabledOption = t.Focused.DisabledOption.UnsetFaint().Foreground(lipgloss.Color("8"))
+
 	t.Blurred = t.Focused
 	t.Blurred.Base = t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
 	t.Blurred.Card = t.Blurred.Base
@@ -327,6 +436,27 @@ func ThemeCatppuccin(isDark bool) *Styles {
 	t.Focused.TextInput.Placeholder = t.Focused.TextInput.Placeholder.Foreground(overlay0)
 	t.Focused.TextInput.Prompt = t.Focused.TextInput.Prompt.Foreground(pink)
 
+	t.Focused.CalendarHeader = t.Focused.CalendarHeader.Foreground(mauve)
+	t.Focused.CalendarWeekday = t.Focused.CalendarWeekday.Foreground(subtext0)
+	t.Focused.CalendarDay = t.Focused.CalendarDay.Foreground(text)
+	t.Focused.CalendarToday = t.Focused.CalendarToday.Foreground(green)
+	t.Focused.CalendarCursor = t.Focused.CalendarCursor.UnsetReverse().Foreground(base).Background(pink)
+	t.Focused.CalendarSelected = t.Focused.CalendarSelected.Foreground(pink)
+	t.Focused.CalendarRange = t.Focused.CalendarRange.UnsetUnderline().Foreground(base).Background(mauve)
+	t.Focused.CalendarDisabled = t.Focused.CalendarDisabled.Foreground(overlay0)
+
+	t.Focused.SliderTrack = t.Focused.SliderTrack.UnsetFaint().Foreground(overlay0)
+	t.Focused.SliderFilled = t.Focused.SliderFilled.Foreground(mauve)
+	t.Focused.SliderThumb = t.Focused.SliderThumb.Foreground(pink)
+	t.Focused.TableHeader = t.Focused.TableHeader.Foreground(mauve)
+	t.Focused.PasswordMeter = t.Focused.PasswordMeter.UnsetFaint().Foreground(overlay0)
+	t.Focused.PasswordWeak = t.Focused.PasswordWeak.Foreground(red)
+	t.Focused.PasswordFair = t.Focused.PasswordFair.Foreground(flavour.Yellow())
+	t.Focused.PasswordStrong = t.Focused.PasswordStrong.Foreground(green)
+	t.Focused.Tag = t.Focused.Tag.UnsetReverse().Foreground(base).Background(mauve)
+	t.Focused.OptionDescription = t.Focused.OptionDescription.UnsetFaint().Foreground(subtext0)
+	t.Focused.DisabledOption = t.Focused.DisabledOption.UnsetFaint().Foreground(overlay0)
+
 	t.Blurred = t.Focused
 	t.Blurred.Base = t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
 	t.Blurred.Card = t.Blurred.Base